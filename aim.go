@@ -0,0 +1,208 @@
+package gogolf
+
+import (
+	"fmt"
+	"math"
+)
+
+// Names of the aim points every hole offers where its layout allows
+const (
+	AimFlag          = "Flag"
+	AimCenterGreen   = "Center Green"
+	AimCenterFairway = "Center Fairway"
+	AimLayupZone     = "Layup Zone"
+	AimPlayTheBreak  = "Play the Break"
+)
+
+// minimumBreak is the least break, in feet, worth aiming outside the hole for
+const minimumBreak = Foot(0.5)
+
+// layupDistance is how far short of the flag a layup tries to leave the ball
+const layupDistance = Yard(100)
+
+// fairwayBand is the depth of fairway, measured back from the furthest reachable cell,
+// that is averaged to find the center of the fairway
+const fairwayBand = Yard(20)
+
+// Aim is where a shot is played toward
+type Aim struct {
+	Name   string
+	Target Point
+	// Distance is how far a full swing is meant to travel; zero plays the club's full distance
+	Distance Yard
+}
+
+// NewAim aims straight at target at full distance
+func NewAim(name string, target Point) Aim {
+	return Aim{Name: name, Target: target}
+}
+
+// Direction returns the vector from the ball to the aim target
+func (a Aim) Direction(from Point) Vector {
+	return from.Direction(a.Target)
+}
+
+// Rotate swings the target around the ball, positive degrees to the right
+func (a Aim) Rotate(from Point, degrees float64) Aim {
+	direction := a.Direction(from)
+	if direction.Magnitude() == 0 || degrees == 0 {
+		return a
+	}
+	a.Target = from.Move(direction.Rotate(degrees), direction.Magnitude())
+	return a
+}
+
+// Angle returns how many degrees the aim is to the right of the line from the ball to point
+func (a Aim) Angle(from, point Point) float64 {
+	line := from.Direction(point)
+	direction := a.Direction(from)
+	if line.Magnitude() == 0 || direction.Magnitude() == 0 {
+		return 0
+	}
+	degrees := line.AngleBetween(direction) * 180 / math.Pi
+	if direction.Dot(line.Rotate(90)) < 0 {
+		return -degrees
+	}
+	return degrees
+}
+
+// WithDistance plays the aim at a chosen distance; zero goes back to the club's full distance
+func (a Aim) WithDistance(distance Yard) Aim {
+	a.Distance = max(distance, 0)
+	return a
+}
+
+// PlannedDistance is how far a full swing with club travels toward this aim
+func (a Aim) PlannedDistance(club Club) Yard {
+	if a.Distance > 0 && a.Distance < club.Distance {
+		return a.Distance
+	}
+	return club.Distance
+}
+
+func (a Aim) String() string {
+	if a.Distance > 0 {
+		return fmt.Sprintf("%s (%.0f yds)", a.Name, a.Distance)
+	}
+	return a.Name
+}
+
+// OffLine measures how far point finishes to the right (positive) or left (negative)
+// of the line from the ball through the aim target
+func (a Aim) OffLine(from, point Point) Yard {
+	direction := a.Direction(from)
+	if direction.Magnitude() == 0 {
+		return 0
+	}
+	right := direction.Rotate(90).Normalize()
+	return Unit(from.Direction(point).Dot(right)).Yards()
+}
+
+// AimPoints lists the named targets available to a ball at from with a club that reaches reach yards.
+// The flag and the center of the green are always offered; the center of the fairway and the layup
+// zone are offered when there is fairway within reach to aim at. On a breaking putt the golfer can
+// also play the break, aiming as far outside the hole as the putt is read to move.
+func (h Hole) AimPoints(from Point, reach Yard) []Aim {
+	aims := []Aim{NewAim(AimFlag, h.HoleLocation)}
+	if h.GetLieAtPosition(from) == Green {
+		if aim, ok := h.playTheBreak(from); ok {
+			aims = append(aims, aim)
+		}
+	}
+	if center, ok := h.centerOf(Green, func(Point) bool { return true }); ok {
+		aims = append(aims, NewAim(AimCenterGreen, center))
+	}
+
+	if h.Grid == nil || from.Distance(h.HoleLocation).Yards() <= reach {
+		return aims
+	}
+
+	reachable := func(p Point) bool { return from.Distance(p).Yards() <= reach }
+	furthest := Yard(0)
+	h.eachCell(Fairway, func(p Point) {
+		if reachable(p) {
+			furthest = max(furthest, from.Distance(p).Yards())
+		}
+	})
+	inBand := func(p Point) bool {
+		return reachable(p) && from.Distance(p).Yards() >= furthest-fairwayBand
+	}
+	if center, ok := h.centerOf(Fairway, inBand); ok {
+		aims = append(aims, NewAim(AimCenterFairway, center))
+	}
+
+	if layup, ok := h.layupZone(from, reachable); ok {
+		aims = append(aims, NewAim(AimLayupZone, layup))
+	}
+	return aims
+}
+
+// eachCell calls visit with the center of every grid cell with the given lie
+func (h Hole) eachCell(lie LieType, visit func(Point)) {
+	if h.Grid == nil {
+		return
+	}
+	for _, row := range h.Grid.Cells {
+		for _, cell := range row {
+			if cell.Lie == lie {
+				visit(cell.Position)
+			}
+		}
+	}
+}
+
+// centerOf returns the cell of lie nearest the average position of the cells accepted by include,
+// so the center of a curving fairway still lands on fairway
+func (h Hole) centerOf(lie LieType, include func(Point) bool) (Point, bool) {
+	var cells []Point
+	var sumX, sumY float64
+	h.eachCell(lie, func(p Point) {
+		if include(p) {
+			cells = append(cells, p)
+			sumX += float64(p.X)
+			sumY += float64(p.Y)
+		}
+	})
+	if len(cells) == 0 {
+		return Point{}, false
+	}
+
+	average := Point{X: int(sumX / float64(len(cells))), Y: int(sumY / float64(len(cells)))}
+	best := cells[0]
+	for _, cell := range cells[1:] {
+		if cell.Distance(average) < best.Distance(average) {
+			best = cell
+		}
+	}
+	return best, true
+}
+
+// layupZone is the reachable fairway cell closest to layupDistance from the flag,
+// never further from the flag than the ball already is
+func (h Hole) layupZone(from Point, reachable func(Point) bool) (Point, bool) {
+	remaining := from.Distance(h.HoleLocation).Yards()
+	var best Point
+	found := false
+	bestGap := Yard(0)
+	h.eachCell(Fairway, func(p Point) {
+		toFlag := p.Distance(h.HoleLocation).Yards()
+		if !reachable(p) || toFlag >= remaining {
+			return
+		}
+		gap := Yard(math.Abs(float64(toFlag - layupDistance)))
+		if !found || gap < bestGap {
+			best, bestGap, found = p, gap, true
+		}
+	})
+	return best, found
+}
+
+// playTheBreak aims outside the hole on the high side by the amount the putt is read to break
+func (h Hole) playTheBreak(from Point) (Aim, bool) {
+	read := h.ReadPutt(from)
+	if math.Abs(float64(read.Break)) < float64(minimumBreak) {
+		return Aim{}, false
+	}
+	right := from.Direction(h.HoleLocation).Rotate(90)
+	return NewAim(AimPlayTheBreak, h.HoleLocation.Move(right, -float64(read.Break.Units()))), true
+}
@@ -0,0 +1,86 @@
+package gogolf
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAim_RotateAndOffLine(t *testing.T) {
+	from := Point{X: 0, Y: 0}
+	aim := NewAim(AimFlag, Point{X: 0, Y: 1000})
+
+	right := aim.Rotate(from, 90)
+
+	if right.Target.X <= 0 || math.Abs(float64(right.Target.Y)) > 1 {
+		t.Errorf("rotating 90 degrees right should aim along +X, got %+v", right.Target)
+	}
+	if got := right.Angle(from, aim.Target); math.Abs(got-90) > 0.1 {
+		t.Errorf("angle = %.1f, want 90", got)
+	}
+	if got := aim.OffLine(from, Point{X: 72, Y: 500}); math.Abs(float64(got)-10) > 0.01 {
+		t.Errorf("off line = %.2f yards, want 10 right", got)
+	}
+	if got := aim.OffLine(from, Point{X: -36, Y: 500}); math.Abs(float64(got)+5) > 0.01 {
+		t.Errorf("off line = %.2f yards, want 5 left", got)
+	}
+}
+
+func TestAim_PlannedDistance(t *testing.T) {
+	club := Club{Name: "7 Iron", Distance: 180}
+	aim := NewAim(AimFlag, Point{})
+
+	if aim.PlannedDistance(club) != 180 {
+		t.Errorf("full aim should use the club's distance, got %.0f", aim.PlannedDistance(club))
+	}
+	if aim.WithDistance(150).PlannedDistance(club) != 150 {
+		t.Errorf("shorter aim should plan 150 yards, got %.0f", aim.WithDistance(150).PlannedDistance(club))
+	}
+	if aim.WithDistance(200).PlannedDistance(club) != 180 {
+		t.Error("aim cannot plan past the club's distance")
+	}
+}
+
+func TestHole_AimPointsFromTee(t *testing.T) {
+	hole := GenerateCourse(1, 4).Holes[0]
+	if hole.Par == 3 {
+		t.Skip("expected a longer opening hole")
+	}
+
+	aims := hole.AimPoints(hole.TeeLocation, 230)
+
+	byName := map[string]Aim{}
+	for _, aim := range aims {
+		byName[aim.Name] = aim
+	}
+	for _, name := range []string{AimFlag, AimCenterGreen, AimCenterFairway, AimLayupZone} {
+		if _, ok := byName[name]; !ok {
+			t.Errorf("expected %q aim point from the tee, got %+v", name, aims)
+		}
+	}
+
+	fairway := byName[AimCenterFairway].Target
+	if hole.GetLieAtPosition(fairway) != Fairway {
+		t.Errorf("center fairway aim %+v is on %v", fairway, hole.GetLieAtPosition(fairway))
+	}
+	if hole.TeeLocation.Distance(fairway).Yards() > 230 {
+		t.Error("center fairway aim should be within reach")
+	}
+	if hole.GetLieAtPosition(byName[AimCenterGreen].Target) != Green {
+		t.Error("center green aim should be on the green")
+	}
+	if byName[AimFlag].Target != hole.HoleLocation {
+		t.Error("flag aim should be the hole")
+	}
+}
+
+func TestHole_AimPointsWithinReachOfGreen(t *testing.T) {
+	hole := GenerateCourse(1, 4).Holes[0]
+
+	aims := hole.AimPoints(hole.HoleLocation.Move(Vector{X: 0, Y: -1}, float64(Yard(100).Units())), 150)
+
+	for _, aim := range aims {
+		if aim.Name == AimCenterFairway || aim.Name == AimLayupZone {
+			t.Errorf("did not expect %q when the green is within reach", aim.Name)
+		}
+	}
+}
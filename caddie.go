@@ -0,0 +1,101 @@
+package gogolf
+
+// AggressiveSelector takes enough club to get all the way there: the shortest club
+// that reaches the distance, or the longest club when none does
+type AggressiveSelector struct{}
+
+func (AggressiveSelector) SelectClub(clubs []Club, distance Yard) Club {
+	var best, longest Club
+	found := false
+	for _, club := range clubs {
+		if club.Name == "Putter" {
+			continue
+		}
+		if club.Distance > longest.Distance {
+			longest = club
+		}
+		if club.Distance >= distance && (!found || club.Distance <= best.Distance) {
+			best, found = club, true
+		}
+	}
+	if !found {
+		return longest
+	}
+	return best
+}
+
+// ConservativeSelector leaves the driver in the bag and never takes more club than the distance,
+// preferring to come up short rather than fly the target
+type ConservativeSelector struct{}
+
+func (ConservativeSelector) SelectClub(clubs []Club, distance Yard) Club {
+	var best, shortest Club
+	found := false
+	for _, club := range clubs {
+		if club.Name == "Putter" || club.Name == "Driver" {
+			continue
+		}
+		if shortest.Name == "" || club.Distance < shortest.Distance {
+			shortest = club
+		}
+		if club.Distance <= distance && (!found || club.Distance >= best.Distance) {
+			best, found = club, true
+		}
+	}
+	if !found {
+		return shortest
+	}
+	return best
+}
+
+// LieAwareSelector narrows the bag to the clubs that can be played from a lie and lets
+// another selector choose among them. A nil Selector plays aggressively.
+type LieAwareSelector struct {
+	Lie      LieType
+	Selector ClubSelector
+}
+
+func (s LieAwareSelector) SelectClub(clubs []Club, distance Yard) Club {
+	if s.Lie == Green {
+		for _, club := range clubs {
+			if club.Name == "Putter" {
+				return club
+			}
+		}
+	}
+
+	var playable []Club
+	for _, club := range clubs {
+		if club.Name != "Putter" && club.Loft >= MinimumLoft(s.Lie) {
+			playable = append(playable, club)
+		}
+	}
+	if len(playable) == 0 {
+		playable = clubs
+	}
+
+	selector := s.Selector
+	if selector == nil {
+		selector = AggressiveSelector{}
+	}
+	return selector.SelectClub(playable, distance)
+}
+
+// MinimumLoft is the least loft that gets the ball up out of a lie. The driver only comes
+// out on the tee, woods need a clean lie and heavy grass and sand call for irons and wedges.
+func MinimumLoft(lie LieType) float32 {
+	switch lie {
+	case Tee:
+		return 0
+	case Fairway, FirstCut:
+		return 12
+	case Rough:
+		return 18
+	case DeepRough:
+		return 25
+	case Bunker:
+		return 40
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,59 @@
+package gogolf
+
+import "testing"
+
+func TestAggressiveSelector_TakesEnoughClub(t *testing.T) {
+	clubs := DefaultClubs()
+
+	if club := (AggressiveSelector{}).SelectClub(clubs, 175); club.Name != "7 Iron" {
+		t.Errorf("aggressive pick for 175 yards = %s, want 7 Iron", club.Name)
+	}
+	if club := (AggressiveSelector{}).SelectClub(clubs, 400); club.Name != "Driver" {
+		t.Errorf("aggressive pick beyond reach = %s, want Driver", club.Name)
+	}
+}
+
+func TestConservativeSelector_ComesUpShort(t *testing.T) {
+	clubs := DefaultClubs()
+
+	if club := (ConservativeSelector{}).SelectClub(clubs, 175); club.Name != "8 Iron" {
+		t.Errorf("conservative pick for 175 yards = %s, want 8 Iron", club.Name)
+	}
+	if club := (ConservativeSelector{}).SelectClub(clubs, 400); club.Name != "3 Wood" {
+		t.Errorf("conservative pick off the tee = %s, want 3 Wood", club.Name)
+	}
+	if club := (ConservativeSelector{}).SelectClub(clubs, 20); club.Name != "LW" {
+		t.Errorf("conservative pick inside every club = %s, want LW", club.Name)
+	}
+}
+
+func TestLieAwareSelector(t *testing.T) {
+	clubs := DefaultClubs()
+	tests := []struct {
+		lie      LieType
+		distance Yard
+		expected string
+	}{
+		{Tee, 400, "Driver"},
+		{Fairway, 400, "3 Wood"},
+		{Rough, 400, "5 Wood"},
+		{DeepRough, 400, "5 Iron"},
+		{Bunker, 150, "PW"},
+		{Green, 200, "Putter"},
+	}
+
+	for _, tt := range tests {
+		club := LieAwareSelector{Lie: tt.lie}.SelectClub(clubs, tt.distance)
+		if club.Name != tt.expected {
+			t.Errorf("lie-aware pick from %v at %.0f yards = %s, want %s", tt.lie, tt.distance, club.Name, tt.expected)
+		}
+	}
+}
+
+func TestLieAwareSelector_DelegatesToSelector(t *testing.T) {
+	selector := LieAwareSelector{Lie: Fairway, Selector: ConservativeSelector{}}
+
+	if club := selector.SelectClub(DefaultClubs(), 175); club.Name != "8 Iron" {
+		t.Errorf("lie-aware conservative pick = %s, want 8 Iron", club.Name)
+	}
+}
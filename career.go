@@ -0,0 +1,408 @@
+package gogolf
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sort"
+)
+
+// Career settings: the field each event is played against and when it is cut
+const (
+	// CareerHoles is the length of every tournament round
+	CareerHoles = 18
+	// CutAfterRound is the round after which the field is cut, the 36-hole cut
+	CutAfterRound = 2
+	// FieldSize is how many simulated golfers the player competes against
+	FieldSize = 39
+	// CutSize is how many golfers, ties included, play on after the cut
+	CutSize = 20
+)
+
+// Event is one tournament on the calendar
+type Event struct {
+	Name string `json:"name"`
+	// CourseSeed generates the event's course, so every round of it is played on the same layout
+	CourseSeed uint64 `json:"course_seed"`
+	Rounds     int    `json:"rounds"`
+	Purse      int    `json:"purse"`
+	// Points is the season points the winner receives; everyone else who makes the cut earns a share
+	Points int `json:"points"`
+}
+
+// Course is the course the event is played on
+func (e Event) Course() Course {
+	course := GenerateCourse(CareerHoles, e.CourseSeed)
+	course.Name = e.Name
+	return course
+}
+
+// HasCut reports whether the field is cut after CutAfterRound
+func (e Event) HasCut() bool {
+	return e.Rounds > CutAfterRound
+}
+
+// seasonSchedule is the tournaments every season is made of, in calendar order
+var seasonSchedule = []Event{
+	{Name: "Desert Classic", Rounds: 3, Purse: 1000, Points: 300},
+	{Name: "Coastal Open", Rounds: 4, Purse: 1500, Points: 500},
+	{Name: "Pinewood Invitational", Rounds: 4, Purse: 2000, Points: 500},
+	{Name: "Lakeside Championship", Rounds: 4, Purse: 1500, Points: 500},
+	{Name: "Tour Championship", Rounds: 4, Purse: 3000, Points: 750},
+}
+
+// fieldNames are the simulated golfers the player competes against
+var fieldNames = []string{
+	"A. Palmer", "B. Hogan", "S. Snead", "G. Player", "J. Nicklaus", "L. Trevino", "T. Watson", "S. Ballesteros",
+	"N. Faldo", "G. Norman", "N. Price", "E. Els", "V. Singh", "P. Mickelson", "R. Goosen", "S. Garcia",
+	"P. Harrington", "L. Donald", "A. Scott", "R. McIlroy", "J. Rose", "B. Watson", "M. Kaymer", "J. Day",
+	"J. Spieth", "D. Johnson", "H. Matsuyama", "J. Thomas", "B. Koepka", "F. Molinari", "J. Rahm", "C. Morikawa",
+	"X. Schauffele", "V. Hovland", "S. Scheffler", "C. Smith", "M. Fitzpatrick", "W. Clark", "T. Fleetwood",
+}
+
+// FieldGolfer is a simulated tour player
+type FieldGolfer struct {
+	Name string `json:"name"`
+	// Rating is the golfer's average score to par over 18 holes; lower is better
+	Rating float64 `json:"rating"`
+}
+
+// fieldScoreSpread is how many strokes a field golfer's 18-hole score typically strays from their rating
+const fieldScoreSpread = 3.0
+
+// Tournament is an event in progress: every golfer's strokes for each round they have played
+type Tournament struct {
+	Event Event `json:"event"`
+	// Par is the par of the event's course for one round
+	Par int `json:"par"`
+	// Scores holds each golfer's strokes round by round
+	Scores map[string][]int `json:"scores"`
+	// Cut lists the golfers sent home at the cut
+	Cut map[string]bool `json:"cut,omitempty"`
+	// RoundsPlayed counts the rounds the whole field has completed
+	RoundsPlayed int `json:"rounds_played"`
+}
+
+// Placing is a golfer's position in a tournament
+type Placing struct {
+	Name     string
+	Position int
+	Tied     bool
+	Strokes  int
+	ToPar    int
+	MadeCut  bool
+	Prize    int
+	Points   int
+}
+
+// EventResult is how the player finished an event
+type EventResult struct {
+	Event    string `json:"event"`
+	Season   int    `json:"season"`
+	Position int    `json:"position"`
+	Tied     bool   `json:"tied,omitempty"`
+	ToPar    int    `json:"to_par"`
+	MadeCut  bool   `json:"made_cut"`
+	Prize    int    `json:"prize"`
+	Points   int    `json:"points"`
+}
+
+// SeasonStanding is a golfer's place on the season points list
+type SeasonStanding struct {
+	Name     string
+	Rank     int
+	Points   int
+	Earnings int
+}
+
+// Career is a golfer's life on tour: the season's calendar, the event being played, the points list
+// and prize money won by everyone on tour, and the player's results
+type Career struct {
+	Player string `json:"player"`
+	Seed   uint64 `json:"seed"`
+	Season int    `json:"season"`
+	// Calendar is this season's events in order; NextEvent indexes the one to be played next
+	Calendar  []Event       `json:"calendar"`
+	NextEvent int           `json:"next_event"`
+	Field     []FieldGolfer `json:"field"`
+	// Points and Earnings are this season's totals for every golfer on tour, the player included
+	Points   map[string]int `json:"points"`
+	Earnings map[string]int `json:"earnings"`
+	// Results records how the player finished every event they have completed, across all seasons
+	Results    []EventResult `json:"results,omitempty"`
+	Tournament *Tournament   `json:"tournament,omitempty"`
+}
+
+// NewCareer starts a player's first season. The seed sets the field and the courses, and with the
+// player's own rounds determines every simulated score. Scores are kept by name, so the player
+// cannot share one with a golfer in the field.
+func NewCareer(player string, seed uint64) (*Career, error) {
+	for _, name := range fieldNames[:FieldSize] {
+		if name == player {
+			return nil, fmt.Errorf("%s is already a golfer on tour", player)
+		}
+	}
+	random := rand.New(rand.NewPCG(seed, seed))
+	c := &Career{Player: player, Seed: seed}
+	for _, name := range fieldNames[:FieldSize] {
+		// ratings run from a few under par for the best to several over
+		rating := math.Round((random.Float64()*10-4)*10) / 10
+		c.Field = append(c.Field, FieldGolfer{Name: name, Rating: rating})
+	}
+	c.startSeason(1)
+	return c, nil
+}
+
+// startSeason lays out the calendar for season and clears the points list
+func (c *Career) startSeason(season int) {
+	c.Season = season
+	c.NextEvent = 0
+	c.Points = map[string]int{}
+	c.Earnings = map[string]int{}
+	c.Calendar = make([]Event, len(seasonSchedule))
+	for i, event := range seasonSchedule {
+		event.CourseSeed = c.Seed + uint64(season*100+i)
+		c.Calendar[i] = event
+	}
+}
+
+// random gives the draws for one round of the current event, so a resumed career simulates the same scores
+func (c *Career) random() RandomSource {
+	stream := uint64(c.Season*10000 + c.NextEvent*100)
+	if c.Tournament != nil {
+		stream += uint64(c.Tournament.RoundsPlayed)
+	}
+	return rand.New(rand.NewPCG(c.Seed, stream))
+}
+
+// IsSeasonComplete reports whether every event on the calendar has been played
+func (c *Career) IsSeasonComplete() bool {
+	return c.NextEvent >= len(c.Calendar)
+}
+
+// StartEvent tees off the next event on the calendar, or returns the one already under way
+func (c *Career) StartEvent() (*Tournament, error) {
+	if c.Tournament != nil {
+		return c.Tournament, nil
+	}
+	if c.IsSeasonComplete() {
+		return nil, fmt.Errorf("season %d is over", c.Season)
+	}
+	event := c.Calendar[c.NextEvent]
+	c.Tournament = &Tournament{Event: event, Par: event.Course().Par(), Scores: map[string][]int{}, Cut: map[string]bool{}}
+	return c.Tournament, nil
+}
+
+// PlayerRound records the player's strokes for the current round, simulates the field's and makes the
+// cut once CutAfterRound is done. A player who misses the cut sees the rest of the event simulated.
+func (c *Career) PlayerRound(strokes int) error {
+	t := c.Tournament
+	if t == nil {
+		return fmt.Errorf("no event is under way")
+	}
+	if t.IsComplete() {
+		return fmt.Errorf("%s is already complete", t.Event.Name)
+	}
+	if t.Cut[c.Player] {
+		return fmt.Errorf("%s missed the cut at the %s", c.Player, t.Event.Name)
+	}
+	t.Scores[c.Player] = append(t.Scores[c.Player], strokes)
+	c.simulateFieldRound()
+	for t.Cut[c.Player] && !t.IsComplete() {
+		c.simulateFieldRound()
+	}
+	return nil
+}
+
+// simulateFieldRound scores a round for every field golfer still in the event and applies the cut
+func (c *Career) simulateFieldRound() {
+	t := c.Tournament
+	random := c.random()
+	for _, golfer := range c.Field {
+		if t.Cut[golfer.Name] {
+			continue
+		}
+		score := golfer.Rating + normal(random)*fieldScoreSpread
+		t.Scores[golfer.Name] = append(t.Scores[golfer.Name], t.Par+int(math.Round(score)))
+	}
+	t.RoundsPlayed++
+	if t.Event.HasCut() && t.RoundsPlayed == CutAfterRound {
+		t.makeCut()
+	}
+}
+
+// normal draws from a standard normal distribution
+func normal(random RandomSource) float64 {
+	u1 := math.Max(random.Float64(), math.SmallestNonzeroFloat64)
+	return math.Sqrt(-2*math.Log(u1)) * math.Cos(2*math.Pi*random.Float64())
+}
+
+// makeCut sends home everyone outside the top CutSize and ties
+func (t *Tournament) makeCut() {
+	placings := t.rank()
+	if len(placings) <= CutSize {
+		return
+	}
+	line := placings[CutSize-1].Strokes
+	if t.Cut == nil {
+		t.Cut = map[string]bool{}
+	}
+	for _, placing := range placings {
+		if placing.Strokes > line {
+			t.Cut[placing.Name] = true
+		}
+	}
+}
+
+// IsComplete reports whether every round has been played
+func (t *Tournament) IsComplete() bool {
+	return t.RoundsPlayed >= t.Event.Rounds
+}
+
+// rank orders golfers by total strokes, ties sharing a position; golfers who missed the cut come last
+func (t *Tournament) rank() []Placing {
+	placings := make([]Placing, 0, len(t.Scores))
+	for name, rounds := range t.Scores {
+		placing := Placing{Name: name, MadeCut: !t.Cut[name]}
+		for _, strokes := range rounds {
+			placing.Strokes += strokes
+		}
+		placing.ToPar = placing.Strokes - t.Par*len(rounds)
+		placings = append(placings, placing)
+	}
+	sort.Slice(placings, func(i, j int) bool {
+		a, b := placings[i], placings[j]
+		if a.MadeCut != b.MadeCut {
+			return a.MadeCut
+		}
+		if a.ToPar != b.ToPar {
+			return a.ToPar < b.ToPar
+		}
+		return a.Name < b.Name
+	})
+	for i := range placings {
+		placings[i].Position = i + 1
+		if i > 0 && placings[i].ToPar == placings[i-1].ToPar && placings[i].MadeCut == placings[i-1].MadeCut {
+			placings[i].Position = placings[i-1].Position
+			placings[i].Tied = true
+			placings[i-1].Tied = true
+		}
+	}
+	return placings
+}
+
+// Leaderboard ranks the field with what each golfer stands to win in prize money and season points.
+// Tied golfers split the prizes for the places they share; golfers who missed the cut win nothing.
+func (t *Tournament) Leaderboard() []Placing {
+	placings := t.rank()
+	for start := 0; start < len(placings); {
+		end := start + 1
+		for end < len(placings) && placings[end].Position == placings[start].Position {
+			end++
+		}
+		var prize, points float64
+		for place := start; place < end; place++ {
+			prize += prizeShare(place) * float64(t.Event.Purse)
+			points += pointsShare(place) * float64(t.Event.Points)
+		}
+		for i := start; i < end; i++ {
+			if placings[i].MadeCut {
+				placings[i].Prize = int(math.Round(prize / float64(end-start)))
+				placings[i].Points = int(math.Round(points / float64(end-start)))
+			}
+		}
+		start = end
+	}
+	return placings
+}
+
+// prizeShares is the share of the purse paid for each finishing place from first
+var prizeShares = []float64{0.18, 0.109, 0.069, 0.049, 0.041, 0.036, 0.0335, 0.031, 0.029, 0.027,
+	0.025, 0.023, 0.021, 0.019, 0.018, 0.017, 0.016, 0.015, 0.014, 0.013}
+
+// prizeShare is the share of the purse paid for place, counted from zero; anyone further back who made the cut gets 1%
+func prizeShare(place int) float64 {
+	if place < len(prizeShares) {
+		return prizeShares[place]
+	}
+	return 0.01
+}
+
+// pointsShares is the share of the winner's points earned for each finishing place from first
+var pointsShares = []float64{1, 0.6, 0.38, 0.27, 0.22, 0.2, 0.18, 0.17, 0.16, 0.15}
+
+// pointsShare is the share of the winner's points earned for place, counted from zero
+func pointsShare(place int) float64 {
+	if place < len(pointsShares) {
+		return pointsShares[place]
+	}
+	return math.Max(0.15-0.01*float64(place-len(pointsShares)+1), 0.02)
+}
+
+// FinishEvent closes the completed event: everyone is paid their prize and points, the player's
+// prize money goes to golfer and the calendar moves on. It returns how the player finished.
+func (c *Career) FinishEvent(golfer *Golfer) (EventResult, error) {
+	t := c.Tournament
+	if t == nil || !t.IsComplete() {
+		return EventResult{}, fmt.Errorf("no completed event to finish")
+	}
+	var result EventResult
+	for _, placing := range t.Leaderboard() {
+		c.Points[placing.Name] += placing.Points
+		c.Earnings[placing.Name] += placing.Prize
+		if placing.Name == c.Player {
+			result = EventResult{
+				Event:    t.Event.Name,
+				Season:   c.Season,
+				Position: placing.Position,
+				Tied:     placing.Tied,
+				ToPar:    placing.ToPar,
+				MadeCut:  placing.MadeCut,
+				Prize:    placing.Prize,
+				Points:   placing.Points,
+			}
+		}
+	}
+	golfer.AddMoney(result.Prize)
+	c.Results = append(c.Results, result)
+	c.Tournament = nil
+	c.NextEvent++
+	return result, nil
+}
+
+// Standings is the season points list, the player included; at the end of the season it is the final ranking
+func (c *Career) Standings() []SeasonStanding {
+	standings := []SeasonStanding{{Name: c.Player, Points: c.Points[c.Player], Earnings: c.Earnings[c.Player]}}
+	for _, golfer := range c.Field {
+		standings = append(standings, SeasonStanding{Name: golfer.Name, Points: c.Points[golfer.Name], Earnings: c.Earnings[golfer.Name]})
+	}
+	sort.SliceStable(standings, func(i, j int) bool {
+		if standings[i].Points != standings[j].Points {
+			return standings[i].Points > standings[j].Points
+		}
+		return standings[i].Earnings > standings[j].Earnings
+	})
+	for i := range standings {
+		standings[i].Rank = i + 1
+	}
+	return standings
+}
+
+// Rank is the player's place on the season points list
+func (c *Career) Rank() int {
+	for _, standing := range c.Standings() {
+		if standing.Name == c.Player {
+			return standing.Rank
+		}
+	}
+	return 0
+}
+
+// StartNextSeason begins a new season once the current one is over
+func (c *Career) StartNextSeason() error {
+	if !c.IsSeasonComplete() {
+		return fmt.Errorf("season %d still has %d events to play", c.Season, len(c.Calendar)-c.NextEvent)
+	}
+	c.startSeason(c.Season + 1)
+	return nil
+}
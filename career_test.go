@@ -0,0 +1,201 @@
+package gogolf
+
+import (
+	"math"
+	"testing"
+)
+
+// newTestCareer starts a career for "Player" with the given seed
+func newTestCareer(t *testing.T, seed uint64) *Career {
+	t.Helper()
+	c, err := NewCareer("Player", seed)
+	if err != nil {
+		t.Fatalf("NewCareer returned error: %v", err)
+	}
+	return c
+}
+
+func TestNewCareerLaysOutTheSeason(t *testing.T) {
+	c := newTestCareer(t, 7)
+
+	if c.Season != 1 || len(c.Calendar) != len(seasonSchedule) || len(c.Field) != FieldSize {
+		t.Fatalf("NewCareer = season %d, %d events, %d in the field", c.Season, len(c.Calendar), len(c.Field))
+	}
+	seen := map[uint64]bool{}
+	for _, event := range c.Calendar {
+		if event.Rounds < 2 {
+			t.Errorf("%s has %d rounds, want a multi-round event", event.Name, event.Rounds)
+		}
+		if seen[event.CourseSeed] {
+			t.Errorf("%s repeats another event's course", event.Name)
+		}
+		seen[event.CourseSeed] = true
+	}
+}
+
+func TestNewCareerRefusesAFieldGolfersName(t *testing.T) {
+	if _, err := NewCareer(fieldNames[0], 7); err == nil {
+		t.Fatalf("NewCareer(%q) returned no error", fieldNames[0])
+	}
+}
+
+func TestTournamentMakesTheCutAfter36Holes(t *testing.T) {
+	c := newTestCareer(t, 7)
+	tournament, err := c.StartEvent()
+	if err != nil {
+		t.Fatalf("StartEvent returned error: %v", err)
+	}
+	if !tournament.Event.HasCut() {
+		t.Fatalf("%s should have a cut", tournament.Event.Name)
+	}
+
+	c.PlayerRound(tournament.Par - 5)
+	if len(tournament.Cut) != 0 {
+		t.Error("nobody should be cut after one round")
+	}
+	c.PlayerRound(tournament.Par - 5)
+
+	madeCut := 0
+	for name := range tournament.Scores {
+		if !tournament.Cut[name] {
+			madeCut++
+		}
+	}
+	if madeCut < CutSize || madeCut == FieldSize+1 {
+		t.Errorf("%d golfers made the cut, want the top %d and ties", madeCut, CutSize)
+	}
+	if tournament.Cut["Player"] {
+		t.Error("ten under after two rounds should make the cut")
+	}
+	for name := range tournament.Cut {
+		if got := len(tournament.Scores[name]); got != CutAfterRound {
+			t.Errorf("%s missed the cut but has %d rounds", name, got)
+		}
+	}
+}
+
+func TestMissedCutSimulatesTheRestOfTheEvent(t *testing.T) {
+	c := newTestCareer(t, 7)
+	tournament, _ := c.StartEvent()
+
+	for range CutAfterRound {
+		c.PlayerRound(tournament.Par + 30)
+	}
+
+	if !tournament.Cut["Player"] || !tournament.IsComplete() {
+		t.Fatalf("a player who misses the cut should see the event finish: cut %v, rounds %d", tournament.Cut["Player"], tournament.RoundsPlayed)
+	}
+	if err := c.PlayerRound(tournament.Par); err == nil {
+		t.Error("expected an error playing on after missing the cut")
+	}
+
+	golfer := NewGolfer("Player")
+	result, err := c.FinishEvent(&golfer)
+	if err != nil {
+		t.Fatalf("FinishEvent returned error: %v", err)
+	}
+	if result.MadeCut || result.Prize != 0 || result.Points != 0 || golfer.Money != 100 {
+		t.Errorf("a missed cut earns nothing, got %+v and %d money", result, golfer.Money)
+	}
+}
+
+func TestLeaderboardSplitsPrizesForTies(t *testing.T) {
+	tournament := Tournament{
+		Event:        Event{Rounds: 1, Purse: 1000, Points: 100},
+		Par:          72,
+		Scores:       map[string][]int{"A": {68}, "B": {68}, "C": {70}, "D": {75}},
+		RoundsPlayed: 1,
+	}
+
+	placings := tournament.Leaderboard()
+
+	wantTie := int(math.Round((prizeShares[0] + prizeShares[1]) / 2 * 1000))
+	if placings[0].Position != 1 || placings[1].Position != 1 || !placings[0].Tied {
+		t.Errorf("A and B should tie for first: %+v", placings[:2])
+	}
+	if placings[0].Prize != wantTie || placings[1].Prize != wantTie {
+		t.Errorf("tied golfers should split first and second, got %d and %d, want %d", placings[0].Prize, placings[1].Prize, wantTie)
+	}
+	if placings[2].Position != 3 || placings[2].Prize != int(math.Round(prizeShares[2]*1000)) {
+		t.Errorf("C should finish third with third prize, got %+v", placings[2])
+	}
+	if placings[2].ToPar != -2 {
+		t.Errorf("ToPar = %d, want -2", placings[2].ToPar)
+	}
+}
+
+func TestSeasonEndsWithARanking(t *testing.T) {
+	c := newTestCareer(t, 3)
+	golfer := NewGolfer("Player")
+
+	for !c.IsSeasonComplete() {
+		tournament, err := c.StartEvent()
+		if err != nil {
+			t.Fatalf("StartEvent returned error: %v", err)
+		}
+		for !tournament.IsComplete() {
+			if err := c.PlayerRound(tournament.Par - 8); err != nil {
+				t.Fatalf("PlayerRound returned error: %v", err)
+			}
+		}
+		if _, err := c.FinishEvent(&golfer); err != nil {
+			t.Fatalf("FinishEvent returned error: %v", err)
+		}
+	}
+
+	if len(c.Results) != len(c.Calendar) {
+		t.Errorf("expected a result for each event, got %d", len(c.Results))
+	}
+	if c.Rank() != 1 {
+		t.Errorf("eight under every round should top the points list, ranked %d", c.Rank())
+	}
+	if golfer.Money <= 100 {
+		t.Errorf("prize money should be paid to the golfer, has %d", golfer.Money)
+	}
+	standings := c.Standings()
+	for i := 1; i < len(standings); i++ {
+		if standings[i].Points > standings[i-1].Points {
+			t.Fatalf("standings out of order at %d: %+v", i, standings[i-1:i+1])
+		}
+	}
+
+	if err := c.StartNextSeason(); err != nil {
+		t.Fatalf("StartNextSeason returned error: %v", err)
+	}
+	if c.Season != 2 || c.NextEvent != 0 || len(c.Points) != 0 {
+		t.Errorf("the new season should start afresh: season %d, event %d, points %v", c.Season, c.NextEvent, c.Points)
+	}
+}
+
+func TestCareerPersistsThroughSaves(t *testing.T) {
+	golfer := NewGolfer("Player")
+	golfer.Career = newTestCareer(t, 5)
+	tournament, _ := golfer.Career.StartEvent()
+	golfer.Career.PlayerRound(tournament.Par)
+
+	saveManager := NewSaveManager(t.TempDir())
+	if err := saveManager.Save(1, golfer); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+	loaded, err := saveManager.Load(1)
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+
+	if loaded.Career == nil || loaded.Career.Tournament == nil {
+		t.Fatal("the career and the event under way should be restored")
+	}
+	restored := loaded.Career.Tournament
+	if restored.RoundsPlayed != 1 || len(restored.Scores) != FieldSize+1 || restored.Scores["Player"][0] != tournament.Par {
+		t.Errorf("restored tournament = %d rounds, %d golfers", restored.RoundsPlayed, len(restored.Scores))
+	}
+
+	// the same career carries on to the same simulated scores
+	golfer.Career.PlayerRound(tournament.Par)
+	loaded.Career.PlayerRound(tournament.Par)
+	for name, rounds := range golfer.Career.Tournament.Scores {
+		if got := loaded.Career.Tournament.Scores[name]; got[1] != rounds[1] {
+			t.Errorf("%s scored %d after loading, want %d", name, got[1], rounds[1])
+		}
+	}
+}
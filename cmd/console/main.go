@@ -1,9 +1,12 @@
 package main
 
 import (
+	"flag"
 	"fmt"
+	"math/rand/v2"
 	"os"
 	"path/filepath"
+	"time"
 
 	"gogolf"
 	"gogolf/game"
@@ -47,7 +50,7 @@ func buildGameState(ctx game.Context, lastShot *ui.ShotDisplay, promptMsg string
 		equipment.ShoesBonus = fmt.Sprintf("-%d lie pen", ctx.Golfer.Shoes.LiePenaltyReduction)
 	}
 
-	return ui.GameState{
+	state := ui.GameState{
 		PlayerName:        ctx.Golfer.Name,
 		Money:             ctx.Golfer.Money,
 		Skills:            skills,
@@ -65,16 +68,37 @@ func buildGameState(ctx game.Context, lastShot *ui.ShotDisplay, promptMsg string
 		BallLocationY:     float64(ctx.Ball.Location.Y),
 		HoleLocationX:     float64(ctx.Hole.HoleLocation.X),
 		HoleLocationY:     float64(ctx.Hole.HoleLocation.Y),
+		PlaysLike:         float64(ctx.PlaysLike),
+		ElevationChange:   float64(ctx.Hole.ElevationChange(ctx.Ball.Location, ctx.Hole.HoleLocation)),
+		Stance:            ctx.Stance.String(),
+		StanceDifficulty:  ctx.Stance.DifficultyModifier(),
 		LastShot:          lastShot,
 		TotalStrokes:      ctx.ScoreCard.TotalStrokes(),
 		ScoreToPar:        ctx.ScoreCard.Score(),
 		StrokesThisHole:   ctx.ScoreCard.TotalStrokesThisHole(ctx.Hole),
+		HasWeather:        true,
+		Points:            points(ctx.Scoring, ctx.Points),
+		WindSpeed:         ctx.Weather.WindSpeed,
+		WindGust:          ctx.Weather.WindSpeed + ctx.Weather.GustSpeed,
+		WindRelative:      ctx.Weather.RelativeWindDirection(ctx.Ball.Location.Direction(ctx.Hole.HoleLocation)),
+		Temperature:       ctx.Weather.Temperature,
+		Rain:              ctx.Weather.Rain.String(),
 		PromptMsg:         promptMsg,
 	}
+	if state.Points != "" {
+		state.ScoringFormat = ctx.Scoring.Name()
+	}
+	if ctx.Lie == gogolf.Green {
+		read := ctx.Hole.ReadPutt(ctx.Ball.Location)
+		state.BreakFeet = float64(read.Break)
+		state.Grade = read.Grade
+		state.Stimp = read.Stimp
+	}
+	return state
 }
 
 func shotResultToDisplay(result game.ShotResult) *ui.ShotDisplay {
-	return &ui.ShotDisplay{
+	display := &ui.ShotDisplay{
 		ClubName:      result.ClubName,
 		IntendedShape: result.IntendedShape.String(),
 		ActualShape:   result.ActualShape.String(),
@@ -87,10 +111,228 @@ func shotResultToDisplay(result game.ShotResult) *ui.ShotDisplay {
 		Rotation:      result.Rotation,
 		RotationDir:   result.RotationDir,
 		Power:         result.Power,
+		Aim:           result.Aim.String(),
+		OffLine:       result.OffLine,
+		Carry:         result.Carry,
+		Roll:          result.Roll,
 		Distance:      result.Distance,
+		Apex:          result.Apex,
 		XPEarned:      result.XPEarned,
 		LevelUps:      result.LevelUps,
 	}
+	if result.ShotType != gogolf.FullSwing {
+		display.ShotType = result.ShotType.String()
+	}
+	if result.Trajectory != gogolf.StandardTrajectory {
+		display.Trajectory = result.Trajectory.String()
+	}
+	if result.SpinOutcome != gogolf.NoSpinEffect {
+		display.SpinOutcome = result.SpinOutcome.String()
+	}
+	if result.ObstacleHit != nil {
+		display.Obstacle = fmt.Sprintf("%s (%s)", result.ObstacleHit.Obstacle.Name, result.ObstacleHit.Outcome)
+	}
+	if result.PenaltyStrokes > 0 {
+		display.Penalty = fmt.Sprintf("%s, stroke and distance (+%d)", result.Penalty, result.PenaltyStrokes)
+	}
+	return display
+}
+
+// stateBuilder renders a player's view of the round; hot-seat rounds add whose turn it is and the leaderboard
+type stateBuilder func(ctx game.Context, lastShot *ui.ShotDisplay, promptMsg string) ui.GameState
+
+// playShot walks the player through choosing and playing their next shot, taking relief if
+// the ball finishes in a penalty area, and returns the shot for display. A suspendable round
+// can be stopped instead when choosing the club, which returns false without playing a shot.
+func playShot(renderer *ui.Renderer, g *game.Game, lastShot *ui.ShotDisplay, build stateBuilder, suspendable bool) (*ui.ShotDisplay, bool) {
+	ctx := g.GetContext()
+	state := build(ctx, lastShot, fmt.Sprintf("Using %s", ctx.CurrentClub.Name))
+	renderer.Render(state)
+
+	club, chosen := selectClub(renderer, g, ctx.CurrentClub, suspendable)
+	if !chosen {
+		return lastShot, false
+	}
+	if club.Name != ctx.CurrentClub.Name {
+		g.SelectClub(club.Name)
+		ctx = g.GetContext()
+	}
+	if types := g.ShotTypes(); len(types) > 1 {
+		g.SelectShotType(ui.NewShotTypeSelector(renderer).SelectShotType(types))
+	}
+	shotType := g.CurrentShotType()
+	modifiedClub := shotType.Apply(ctx.Golfer.GetModifiedClub(ctx.CurrentClub))
+
+	aimSelector := ui.NewAimSelector(renderer)
+	aim := aimSelector.SelectAim(aimChoices(g), ctx.Ball.Location, modifiedClub.Distance)
+
+	shape := gogolf.Straight
+	if ctx.CurrentClub.Name != "Putter" && (shotType == gogolf.FullSwing || shotType == gogolf.Punch) {
+		shapeSelector := ui.NewShotShapeSelector(renderer)
+		shape = shapeSelector.SelectShotShape()
+	}
+	if ctx.CurrentClub.Name != "Putter" {
+		trajectory, spin := ui.NewFlightSelector(renderer).SelectFlight()
+		g.SelectTrajectory(trajectory)
+		g.SelectSpin(spin)
+	}
+	powerMeter := ui.NewPowerMeter(renderer)
+
+	if ctx.CurrentClub.Name == "Putter" {
+		distanceYards := ctx.Ball.Location.Distance(ctx.Hole.HoleLocation).Yards()
+		distanceFeet := float64(distanceYards.Feet())
+		powerMeter.SetPuttingModeWithClubDistance(distanceFeet, float64(modifiedClub.Distance))
+	} else {
+		powerMeter.SetClubDistance(float64(aim.PlannedDistance(modifiedClub)))
+		powerMeter.SetElevationChange(float64(ctx.Hole.ElevationChange(ctx.Ball.Location, aim.Target).Yards()))
+	}
+	power := powerMeter.GetPower()
+
+	result, err := g.TakeShotWithShape(power, shape, aim)
+	if err != nil {
+		state := build(g.GetContext(), lastShot, "Press any key to continue...")
+		state.StatusMsg = err.Error()
+		renderer.Render(state)
+		renderer.Terminal.ShowCursor()
+		ui.WaitForAnyKey()
+		renderer.Terminal.HideCursor()
+		return lastShot, true
+	}
+
+	diceRoller := ui.NewDiceRoller(renderer)
+	diceRoller.ShowRoll(result.DiceRolls, result.TargetNumber)
+
+	lastShot = shotResultToDisplay(result)
+
+	if result.TapIn {
+		lastShot.Description += " (Tap in)"
+	}
+
+	if relief := g.PendingRelief(); relief != nil {
+		renderer.Render(build(g.GetContext(), lastShot, "Ball in the penalty area"))
+		labels := make([]string, len(relief.Options))
+		for i, choice := range relief.Options {
+			labels[i] = fmt.Sprintf("%s - %s, %.0f yds", choice.Option, choice.Lie, choice.DistanceToHole)
+		}
+		choice := ui.NewReliefSelector(renderer).SelectRelief(labels)
+		if err := g.TakeRelief(relief.Options[choice].Option); err == nil {
+			lastShot.Penalty = fmt.Sprintf("%s, %s (+1)", result.Penalty, relief.Options[choice].Option)
+		}
+	}
+
+	return lastShot, true
+}
+
+// takeTurn plays the next shot for whoever g belongs to: the player at the keyboard or the computer
+func takeTurn(renderer *ui.Renderer, g *game.Game, lastShot *ui.ShotDisplay, build stateBuilder) *ui.ShotDisplay {
+	if g.Computer == nil {
+		lastShot, _ = playShot(renderer, g, lastShot, build, false)
+		return lastShot
+	}
+	return playComputerShot(renderer, g, lastShot, build)
+}
+
+// playComputerShot shows a computer golfer thinking over their shot, then plays it and any relief it needs
+func playComputerShot(renderer *ui.Renderer, g *game.Game, lastShot *ui.ShotDisplay, build stateBuilder) *ui.ShotDisplay {
+	renderer.Render(build(g.GetContext(), lastShot, fmt.Sprintf("%s is playing...", g.Golfer.Name)))
+	result, err := g.PlayComputerShot()
+	if err != nil {
+		state := build(g.GetContext(), lastShot, "Press any key to continue...")
+		state.StatusMsg = err.Error()
+		renderer.Render(state)
+		renderer.Terminal.ShowCursor()
+		ui.WaitForAnyKey()
+		renderer.Terminal.HideCursor()
+		return lastShot
+	}
+	ui.NewDiceRoller(renderer).ShowRoll(result.DiceRolls, result.TargetNumber)
+
+	lastShot = shotResultToDisplay(result)
+	if result.TapIn {
+		lastShot.Description += " (Tap in)"
+	}
+	if result.Penalty == gogolf.InPenaltyArea {
+		lastShot.Penalty = fmt.Sprintf("%s, relief taken (+%d)", result.Penalty, result.PenaltyStrokes)
+	}
+	return lastShot
+}
+
+// selectClub lets the player cycle the bag, starting from the caddie's pick
+func selectClub(renderer *ui.Renderer, g *game.Game, current gogolf.Club, suspendable bool) (gogolf.Club, bool) {
+	options := g.ClubOptions()
+	labels := make([]string, len(options))
+	selected := 0
+	for i, option := range options {
+		if option.Club.Name == current.Name {
+			selected = i
+			labels[i] = fmt.Sprintf("%s - carry %.0f yds (caddie's pick)", option.Club.Name, option.Carry)
+			continue
+		}
+		labels[i] = fmt.Sprintf("%s - carry %.0f yds", option.Club.Name, option.Carry)
+		if option.Club.Name == "Putter" {
+			labels[i] = fmt.Sprintf("%s - %.0f yds", option.Club.Name, option.Total)
+		}
+	}
+	picker := ui.NewClubPicker(renderer)
+	picker.Suspendable = suspendable
+	choice := picker.SelectClub(labels, selected)
+	if choice == ui.SuspendRound {
+		return gogolf.Club{}, false
+	}
+	return options[choice].Club, true
+}
+
+// aimChoices puts the game's default aim first so Enter plays it
+func aimChoices(g *game.Game) []gogolf.Aim {
+	defaultAim := g.DefaultAim()
+	choices := []gogolf.Aim{defaultAim}
+	for _, aim := range g.AimPoints() {
+		if aim.Name != defaultAim.Name {
+			choices = append(choices, aim)
+		}
+	}
+	return choices
+}
+
+// roundConfig holds the command-line choices used to start each round
+type roundConfig struct {
+	seed      uint64
+	holeCount int
+	course    *gogolf.Course
+	matchPlay bool
+	scoring   gogolf.ScoringFormat
+	// team is the format when the golfers play as one team; nil when they play their own rounds
+	team *game.TeamFormat
+	// computers maps the index of each computer golfer to how strong they are
+	computers map[int]game.Difficulty
+	// history keeps every finished round
+	history *gogolf.HistoryStore
+}
+
+// recordRound keeps g's finished round in the history; failing to is reported but doesn't stop play
+func (rc roundConfig) recordRound(g *game.Game) {
+	round := gogolf.NewRoundRecord(g.Golfer, g.ScoreCard, g.ScoringFormat().Name(), time.Now())
+	if err := rc.history.Add(round); err != nil {
+		fmt.Printf("Error saving round history: %v\n", err)
+	}
+}
+
+// seatComputers hands the computer golfers' games to the computer
+func (rc roundConfig) seatComputers(players []*game.Game) {
+	for i, difficulty := range rc.computers {
+		players[i].Computer = &game.ComputerPlayer{Difficulty: difficulty}
+	}
+}
+
+func (rc roundConfig) newGame(golfer gogolf.Golfer) *game.Game {
+	var g *game.Game
+	if rc.course != nil {
+		g = game.NewWithCourse(golfer, *rc.course, game.NewSeededRandom(rc.seed))
+	} else {
+		g = game.NewFromGolferWithSeed(golfer, rc.holeCount, rc.seed)
+	}
+	g.Scoring = rc.scoring
+	return g
 }
 
 func getSaveDir() string {
@@ -101,29 +343,145 @@ func getSaveDir() string {
 	return filepath.Join(homeDir, ".gogolf_saves")
 }
 
-func showStartupMenu(saveManager *gogolf.SaveManager) *game.Game {
+// newGroup starts a hot-seat round for golfers on the configured course
+func (rc roundConfig) newGroup(golfers []gogolf.Golfer) (*game.Group, error) {
+	var group *game.Group
+	var err error
+	switch {
+	case rc.matchPlay && rc.course != nil:
+		group, err = game.NewMatchPlay(golfers, *rc.course, game.NewSeededRandom(rc.seed))
+	case rc.matchPlay:
+		group, err = game.NewMatchPlayWithSeed(golfers, rc.holeCount, rc.seed)
+	case rc.course != nil:
+		group, err = game.NewGroup(golfers, *rc.course, game.NewSeededRandom(rc.seed))
+	default:
+		group, err = game.NewGroupWithSeed(golfers, rc.holeCount, rc.seed)
+	}
+	if err != nil {
+		return nil, err
+	}
+	group.SetScoring(rc.scoring)
+	rc.seatComputers(group.Players)
+	return group, nil
+}
+
+// newTeam starts a round for golfers playing together as one team on the configured course
+func (rc roundConfig) newTeam(golfers []gogolf.Golfer) (*game.Team, error) {
+	var team *game.Team
+	var err error
+	if rc.course != nil {
+		team, err = game.NewTeam(*rc.team, golfers, *rc.course, game.NewSeededRandom(rc.seed))
+	} else {
+		team, err = game.NewTeamWithSeed(*rc.team, golfers, rc.holeCount, rc.seed)
+	}
+	if err != nil {
+		return nil, err
+	}
+	team.SetScoring(rc.scoring)
+	rc.seatComputers(team.Players)
+	return team, nil
+}
+
+// chooseFormat asks how the round is played and scored. Skins needs opponents, match play and
+// foursomes exactly two golfers; a team format then asks how the team's card is scored.
+func (rc *roundConfig) chooseFormat(players int) {
+	var options []ui.MenuOption
+	var formats []gogolf.ScoringFormat
+	for _, format := range gogolf.ScoringFormats() {
+		if _, skins := format.(gogolf.Skins); skins && players < 2 {
+			continue
+		}
+		options = append(options, ui.MenuOption{Label: format.Name(), Value: format.Name()})
+		formats = append(formats, format)
+	}
+	if players == 2 {
+		options = append(options, ui.MenuOption{Label: "Match Play", Value: "match"})
+	}
+	teamFormats := []game.TeamFormat{game.Scramble, game.BestBall}
+	if players == 2 {
+		teamFormats = append(teamFormats, game.Foursomes)
+	}
+	if players > 1 {
+		for _, format := range teamFormats {
+			options = append(options, ui.MenuOption{Label: format.String() + " (team)", Value: "team"})
+		}
+	}
+
+	choice := ui.ShowMenu("Format", options)
+
+	switch {
+	case options[choice].Value == "match":
+		rc.matchPlay = true
+	case options[choice].Value == "team":
+		team := teamFormats[choice-len(options)+len(teamFormats)]
+		rc.team = &team
+		fmt.Println("\nHow is the team's card scored?")
+		rc.chooseFormat(1)
+	default:
+		rc.scoring = formats[choice]
+	}
+}
+
+// showStartupMenu returns the golfers playing the round: one for a solo game or a tour career,
+// more for hot-seat, with the computer golfers among them by index. Continuing a suspended round
+// returns its golfer and the round itself, ready to play on.
+func showStartupMenu(saveManager *gogolf.SaveManager, history *gogolf.HistoryStore) ([]gogolf.Golfer, map[int]game.Difficulty, *game.Game) {
 	for {
-		options := []ui.MenuOption{
+		var options []ui.MenuOption
+		if saveManager.HasSuspendedRound() {
+			options = append(options, ui.MenuOption{Label: "Continue Round", Value: "continue"})
+		}
+		options = append(options, []ui.MenuOption{
 			{Label: "New Game", Value: "new"},
 			{Label: "Load Game", Value: "load"},
+			{Label: "Tour Career", Value: "career"},
+			{Label: "Multiplayer", Value: "multi"},
+			{Label: "Records", Value: "records"},
 			{Label: "Quit", Value: "quit"},
-		}
+		}...)
 
 		choice := ui.ShowMenu("GoGolf", options)
 
 		switch options[choice].Value {
+		case "continue":
+			if g := continueRound(saveManager); g != nil {
+				return []gogolf.Golfer{g.Golfer}, nil, g
+			}
+
 		case "new":
 			name := ui.PromptString("Enter your golfer's name: ")
 			if name == "" {
 				name = "Player"
 			}
-			return game.New(name, 3)
+			return []gogolf.Golfer{gogolf.NewGolfer(name)}, nil, nil
 
 		case "load":
-			g := showLoadMenu(saveManager)
-			if g != nil {
-				return g
+			if golfer := showLoadMenu(saveManager); golfer != nil {
+				return []gogolf.Golfer{*golfer}, nil, nil
+			}
+
+		case "career":
+			name := ui.PromptString("Enter your golfer's name: ")
+			if name == "" {
+				name = "Player"
+			}
+			career, err := gogolf.NewCareer(name, rand.Uint64())
+			if err != nil {
+				fmt.Printf("\nError starting career: %v\n", err)
+				fmt.Println("Press Enter to continue...")
+				ui.PromptString("")
+				continue
 			}
+			golfer := gogolf.NewGolfer(name)
+			golfer.Career = career
+			return []gogolf.Golfer{golfer}, nil, nil
+
+		case "multi":
+			golfers, computers := showMultiplayerMenu(saveManager)
+			return golfers, computers, nil
+
+		case "records":
+			showRecords(history, ui.PromptString("Golfer's name (blank for everyone): "))
 
 		case "quit":
 			fmt.Println("Goodbye!")
@@ -132,7 +490,71 @@ func showStartupMenu(saveManager *gogolf.SaveManager) *game.Game {
 	}
 }
 
-func showLoadMenu(saveManager *gogolf.SaveManager) *game.Game {
+// continueRound picks the suspended round back up, removing it so it can't be played twice
+func continueRound(saveManager *gogolf.SaveManager) *game.Game {
+	golfer, round, err := saveManager.LoadSuspendedRound()
+	if err != nil {
+		fmt.Printf("Error loading suspended round: %v\n", err)
+		return nil
+	}
+	g, err := game.Resume(golfer, round)
+	if err != nil {
+		fmt.Printf("Error resuming round: %v\n", err)
+		return nil
+	}
+	if err := saveManager.ClearSuspendedRound(); err != nil {
+		fmt.Printf("Error clearing suspended round: %v\n", err)
+	}
+	return g
+}
+
+// showMultiplayerMenu asks how many golfers are playing and whether each is new, loaded from a slot
+// or played by the computer
+func showMultiplayerMenu(saveManager *gogolf.SaveManager) ([]gogolf.Golfer, map[int]game.Difficulty) {
+	count := ui.PromptInt(fmt.Sprintf("How many players (%d-%d)? ", game.MinPlayers, game.MaxPlayers), game.MinPlayers, game.MaxPlayers)
+	golfers := make([]gogolf.Golfer, 0, count)
+	computers := map[int]game.Difficulty{}
+	for len(golfers) < count {
+		player := len(golfers) + 1
+		options := []ui.MenuOption{
+			{Label: "New Golfer", Value: "new"},
+			{Label: "Load Golfer", Value: "load"},
+			{Label: "Computer Golfer", Value: "computer"},
+		}
+
+		choice := ui.ShowMenu(fmt.Sprintf("Player %d", player), options)
+
+		switch options[choice].Value {
+		case "new":
+			name := ui.PromptString(fmt.Sprintf("Enter player %d's name: ", player))
+			if name == "" {
+				name = fmt.Sprintf("Player %d", player)
+			}
+			golfers = append(golfers, gogolf.NewGolfer(name))
+		case "load":
+			if golfer := showLoadMenu(saveManager); golfer != nil {
+				golfers = append(golfers, *golfer)
+			}
+		case "computer":
+			difficulty := chooseDifficulty()
+			computers[len(golfers)] = difficulty
+			golfers = append(golfers, game.NewComputerGolfer(fmt.Sprintf("CPU %d (%s)", player, difficulty), difficulty))
+		}
+	}
+	return golfers, computers
+}
+
+// chooseDifficulty asks how strong a computer golfer should be
+func chooseDifficulty() game.Difficulty {
+	difficulties := game.Difficulties()
+	options := make([]ui.MenuOption, len(difficulties))
+	for i, difficulty := range difficulties {
+		options[i] = ui.MenuOption{Label: difficulty.String(), Value: difficulty.String()}
+	}
+	return difficulties[ui.ShowMenu("Difficulty", options)]
+}
+
+func showLoadMenu(saveManager *gogolf.SaveManager) *gogolf.Golfer {
 	slots := saveManager.ListSaveSlots()
 
 	if len(slots) == 0 {
@@ -166,7 +588,7 @@ func showLoadMenu(saveManager *gogolf.SaveManager) *game.Game {
 	}
 
 	fmt.Printf("\nLoaded %s from slot %d\n", golfer.Name, slot)
-	return game.NewFromGolfer(golfer, 3)
+	return &golfer
 }
 
 func showSaveMenu(saveManager *gogolf.SaveManager, golfer gogolf.Golfer) {
@@ -207,9 +629,40 @@ func showSaveMenu(saveManager *gogolf.SaveManager, golfer gogolf.Golfer) {
 }
 
 func main() {
+	seedFlag := flag.Uint64("seed", 0, "seed for the round's random draws (0 picks one at random)")
+	courseFlag := flag.String("course", "", "path to a course file to play")
+	holesFlag := flag.Int("holes", 3, "number of holes in a generated course (e.g. 9 or 18)")
+	flag.Parse()
+
+	if *holesFlag < 1 {
+		fmt.Printf("Error: --holes must be at least 1, got %d\n", *holesFlag)
+		os.Exit(1)
+	}
+
+	config := roundConfig{seed: *seedFlag, holeCount: *holesFlag}
+	if config.seed == 0 {
+		config.seed = rand.Uint64()
+	}
+	if *courseFlag != "" {
+		course, err := gogolf.LoadCourse(*courseFlag)
+		if err != nil {
+			fmt.Printf("Error loading course: %v\n", err)
+			os.Exit(1)
+		}
+		config.course = &course
+	}
+
 	saveManager := gogolf.NewSaveManager(getSaveDir())
+	config.history = gogolf.NewHistoryStore(getSaveDir())
 
-	g := showStartupMenu(saveManager)
+	golfers, computers, resumed := showStartupMenu(saveManager, config.history)
+	config.computers = computers
+	// a career's events are always stroke play for prize money, and a resumed round keeps its format
+	if resumed != nil {
+		config.scoring = resumed.Scoring
+	} else if len(golfers) > 1 || golfers[0].Career == nil {
+		config.chooseFormat(len(golfers))
+	}
 
 	renderer := ui.NewRenderer()
 	defer renderer.Terminal.ShowCursor()
@@ -222,82 +675,633 @@ func main() {
 
 	renderer.Terminal.HideCursor()
 
+	if config.team != nil {
+		playTeamRounds(renderer, saveManager, config, golfers)
+		return
+	}
+	if len(golfers) > 1 {
+		playGroupRounds(renderer, saveManager, config, golfers)
+		return
+	}
+
+	if len(golfers) == 1 && golfers[0].Career != nil {
+		playCareer(renderer, saveManager, config, &golfers[0], resumed)
+		return
+	}
+
+	g := resumed
+	if g == nil {
+		g = config.newGame(golfers[0])
+	}
 	for {
-		for !g.IsRoundComplete() {
+		if !playRound(renderer, g) {
+			suspendRound(renderer, saveManager, g)
+			return
+		}
+		config.recordRound(g)
+
+		renderer.Terminal.Clear()
+		renderer.Terminal.ShowCursor()
+		fmt.Println("\n=== Round Complete ===")
+		fmt.Printf("Final Score: %d (%+d)\n", g.ScoreCard.TotalStrokes(), g.ScoreCard.Score())
+		if handicap := g.ScoreCard.CourseHandicap; handicap != 0 {
+			fmt.Printf("Net Score: %d (%+d) off a course handicap of %d\n", g.ScoreCard.NetTotalStrokes(), g.ScoreCard.NetScore(), handicap)
+		}
+		if posted := postScore(g); posted != "" {
+			fmt.Println(posted)
+		}
+		if result := points(g.ScoringFormat(), g.Points()); result != "" {
+			fmt.Printf("%s: %s\n", g.ScoringFormat().Name(), result)
+		}
+		fmt.Printf("Weather: %s\n", g.Weather)
+		if penalties := g.ScoreCard.TotalPenalties(); penalties > 0 {
+			fmt.Printf("Penalty strokes: %d\n", penalties)
+		}
+		fmt.Printf("Money: %d\n", g.Golfer.Money)
+		fmt.Printf("Course: %s\n", g.Course.Name)
+		if g != resumed {
+			fmt.Printf("Seed: %d (replay with --seed %d)\n", config.seed, config.seed)
+		}
+		ui.ShowScoreCard(os.Stdout, g.Golfer.Name, g.ScoreCard)
+		ui.ShowStrokesGained(os.Stdout, g.StrokesGained())
+		fmt.Println()
+		displayPlayerStats(g.Golfer)
+
+		if !showPostRoundMenu(saveManager, config.history, &g.Golfer) {
+			return
+		}
+		config.seed++
+		g = config.newGame(g.Golfer)
+	}
+}
+
+// playRound plays a solo golfer's round hole by hole from wherever it stands, so a resumed
+// round picks up mid-hole. It returns false if the golfer stops to save the round.
+func playRound(renderer *ui.Renderer, g *game.Game) bool {
+	for !g.IsRoundComplete() {
+		var lastShot *ui.ShotDisplay
+		if g.StrokesThisHole() == 0 {
 			g.TeeUp()
-			var lastShot *ui.ShotDisplay
-
-			for !g.IsHoleComplete() {
-				ctx := g.GetContext()
-				state := buildGameState(ctx, lastShot, fmt.Sprintf("Using %s", ctx.CurrentClub.Name))
-				renderer.Render(state)
-
-				var shape gogolf.ShotShape
-				if ctx.CurrentClub.Name == "Putter" {
-					shape = gogolf.Straight
-				} else {
-					shapeSelector := ui.NewShotShapeSelector(renderer)
-					shape = shapeSelector.SelectShotShape()
+		} else if result := g.GetLastShotResult(); result != nil {
+			lastShot = shotResultToDisplay(*result)
+		}
+
+		for !g.IsHoleComplete() {
+			shot, played := playShot(renderer, g, lastShot, buildGameState, true)
+			if !played {
+				return false
+			}
+			lastShot = shot
+		}
+
+		reward := g.CompleteHole()
+		ctx := g.GetContext()
+		statusMsg := fmt.Sprintf("Hole %d Complete! %d strokes (%+d)",
+			ctx.Hole.Number, g.StrokesThisHole(), ctx.ScoreCard.ScoreThisHole(ctx.Hole))
+		if stroke, ok := g.ScoringFormat().(gogolf.StrokePlay); !ok || !stroke.ForPurse {
+			statusMsg += fmt.Sprintf(" | +%d money", reward)
+		}
+		state := buildGameState(ctx, lastShot, "Press any key to continue...")
+		state.StatusMsg = statusMsg
+		renderer.Render(state)
+
+		renderer.Terminal.ShowCursor()
+		ui.WaitForAnyKey()
+		renderer.Terminal.HideCursor()
+
+		g.NextHole()
+	}
+	return true
+}
+
+// suspendRound saves the round the golfer stopped partway through, to be picked up again from the startup menu
+func suspendRound(renderer *ui.Renderer, saveManager *gogolf.SaveManager, g *game.Game) {
+	renderer.Terminal.Clear()
+	renderer.Terminal.ShowCursor()
+	round, err := g.Suspend()
+	if err == nil {
+		err = saveManager.SuspendRound(g.Golfer, round)
+	}
+	if err != nil {
+		fmt.Printf("Error saving round: %v\n", err)
+		return
+	}
+	fmt.Printf("Round saved on hole %d. Choose Continue Round from the menu to pick it up.\n", g.GetCurrentHole().Number)
+}
+
+// playCareer plays the golfer's tour career a round at a time: each event's rounds on its own course,
+// the leaderboard after every round, the golfer's finish and the points list after every event and
+// the final ranking at the end of each season. Prize money replaces the usual per-hole rewards.
+// A round resumed from the startup menu is played first, as the event's current round.
+func playCareer(renderer *ui.Renderer, saveManager *gogolf.SaveManager, config roundConfig, golfer *gogolf.Golfer, resumed *game.Game) {
+	career := golfer.Career
+	for {
+		renderer.Terminal.HideCursor()
+		if career.IsSeasonComplete() {
+			renderer.Terminal.Clear()
+			renderer.Terminal.ShowCursor()
+			fmt.Printf("\n=== Season %d Final Ranking ===\n", career.Season)
+			printStandings(career)
+			fmt.Printf("\n%s finished the season ranked %d\n", career.Player, career.Rank())
+			if !showCareerMenu(saveManager, config.history, golfer, fmt.Sprintf("Start Season %d", career.Season+1)) {
+				return
+			}
+			if err := career.StartNextSeason(); err != nil {
+				fmt.Printf("Error starting season: %v\n", err)
+				return
+			}
+			continue
+		}
+
+		tournament, err := career.StartEvent()
+		if err != nil {
+			renderer.Terminal.ShowCursor()
+			fmt.Printf("Error starting event: %v\n", err)
+			return
+		}
+
+		if tournament.IsComplete() {
+			result, err := career.FinishEvent(golfer)
+			if err != nil {
+				renderer.Terminal.ShowCursor()
+				fmt.Printf("Error finishing event: %v\n", err)
+				return
+			}
+			renderer.Terminal.Clear()
+			renderer.Terminal.ShowCursor()
+			fmt.Printf("\n=== %s Complete ===\n", result.Event)
+			if result.MadeCut {
+				fmt.Printf("Finished %s at %+d | Prize: %d | Points: %d\n", position(result.Position, result.Tied), result.ToPar, result.Prize, result.Points)
+			} else {
+				fmt.Printf("Missed the cut at %+d\n", result.ToPar)
+			}
+			fmt.Printf("Money: %d\n", golfer.Money)
+			fmt.Printf("\n=== Season %d Points List ===\n", career.Season)
+			printStandings(career)
+			if !showCareerMenu(saveManager, config.history, golfer, "Next Event") {
+				return
+			}
+			continue
+		}
+
+		g := resumed
+		if g == nil {
+			g = game.NewWithCourse(*golfer, tournament.Event.Course(), game.NewSeededRandom(config.seed))
+		}
+		resumed = nil
+		g.Scoring = gogolf.StrokePlay{ForPurse: true}
+		if !playRound(renderer, g) {
+			suspendRound(renderer, saveManager, g)
+			return
+		}
+		config.recordRound(g)
+		posted := postScore(g)
+		*golfer = g.Golfer
+		if err := career.PlayerRound(g.ScoreCard.TotalStrokes()); err != nil {
+			renderer.Terminal.ShowCursor()
+			fmt.Printf("Error recording round: %v\n", err)
+			return
+		}
+		config.seed++
+
+		renderer.Terminal.Clear()
+		renderer.Terminal.ShowCursor()
+		fmt.Printf("\n=== %s, Round %d of %d ===\n", tournament.Event.Name, tournament.RoundsPlayed, tournament.Event.Rounds)
+		fmt.Printf("%s: %d (%+d) on %s | Weather: %s\n", golfer.Name, g.ScoreCard.TotalStrokes(), g.ScoreCard.Score(), g.Course.Name, g.Weather)
+		if posted != "" {
+			fmt.Println(posted)
+		}
+		ui.ShowScoreCard(os.Stdout, golfer.Name, g.ScoreCard)
+		ui.ShowStrokesGained(os.Stdout, g.StrokesGained())
+		if tournament.Event.HasCut() && tournament.RoundsPlayed == gogolf.CutAfterRound {
+			if tournament.Cut[career.Player] {
+				fmt.Println("Missed the cut")
+			} else {
+				fmt.Println("Made the cut!")
+			}
+		}
+		fmt.Println()
+		printLeaderboard(tournament.Leaderboard(), career.Player)
+		if !showCareerMenu(saveManager, config.history, golfer, "Continue") {
+			return
+		}
+	}
+}
+
+// postScore enters a finished round in the golfer's scoring record and describes the differential
+// and their handicap index; a round that can't be posted, such as a match that finished early, gives "".
+// Picked-up holes are posted at their most likely score.
+func postScore(g *game.Game) string {
+	posted, err := g.PostScore()
+	if err != nil {
+		return ""
+	}
+	summary := fmt.Sprintf("Differential: %.1f", posted.Differential())
+	if g.ScoreCard.HasPickUps() {
+		summary += " (picked-up holes at their most likely score)"
+	}
+	if index, ok := g.Golfer.HandicapIndex(); ok {
+		return summary + fmt.Sprintf(" | Handicap Index: %.1f", index)
+	}
+	return summary + " | Handicap Index: not yet established"
+}
+
+// careerBoardSize is how many of the leaders and the points list are shown
+const careerBoardSize = 10
+
+// position is a finishing place with a T for a tie
+func position(place int, tied bool) string {
+	if tied {
+		return fmt.Sprintf("T%d", place)
+	}
+	return fmt.Sprintf("%d", place)
+}
+
+// printLeaderboard shows the leaders and, if they are further down, the player
+func printLeaderboard(placings []gogolf.Placing, player string) {
+	for i, placing := range placings {
+		if i >= careerBoardSize && placing.Name != player {
+			continue
+		}
+		status := ""
+		if !placing.MadeCut {
+			status = " (MC)"
+		}
+		fmt.Printf("%4s  %-16s %+4d  %d%s\n", position(placing.Position, placing.Tied), placing.Name, placing.ToPar, placing.Strokes, status)
+	}
+}
+
+// printStandings shows the top of the season points list and, if they are further down, the player
+func printStandings(career *gogolf.Career) {
+	for _, standing := range career.Standings() {
+		if standing.Rank > careerBoardSize && standing.Name != career.Player {
+			continue
+		}
+		fmt.Printf("%4d  %-16s %5d pts  %d\n", standing.Rank, standing.Name, standing.Points, standing.Earnings)
+	}
+}
+
+// showCareerMenu is the menu between career rounds; play carries the career on
+func showCareerMenu(saveManager *gogolf.SaveManager, history *gogolf.HistoryStore, golfer *gogolf.Golfer, play string) bool {
+	proshop := gogolf.NewProShop()
+
+	for {
+		options := []ui.MenuOption{
+			{Label: play, Value: "play"},
+			{Label: "Visit ProShop", Value: "shop"},
+			{Label: "Save Game", Value: "save"},
+			{Label: "Records", Value: "records"},
+			{Label: "Quit", Value: "quit"},
+		}
+
+		choice := ui.ShowMenu("What would you like to do?", options)
+
+		switch options[choice].Value {
+		case "play":
+			return true
+		case "shop":
+			shopUI := ui.NewShopUI(proshop, os.Stdout, os.Stdin)
+			shopUI.Show(golfer)
+		case "save":
+			showSaveMenu(saveManager, *golfer)
+		case "records":
+			showRecords(history, golfer.Name)
+		case "quit":
+			fmt.Println("Thanks for playing!")
+			return false
+		}
+	}
+}
+
+// playGroupRounds plays hot-seat rounds, handing the keyboard to whoever is next to play,
+// until the group chooses to stop
+func playGroupRounds(renderer *ui.Renderer, saveManager *gogolf.SaveManager, config roundConfig, golfers []gogolf.Golfer) {
+	for {
+		group, err := config.newGroup(golfers)
+		if err != nil {
+			renderer.Terminal.ShowCursor()
+			fmt.Printf("Error starting round: %v\n", err)
+			return
+		}
+
+		for !group.IsRoundComplete() {
+			group.TeeUp()
+			lastShots := make([]*ui.ShotDisplay, len(group.Players))
+
+			for !group.IsHoleComplete() {
+				player, reason := group.NextToPlay()
+				g := group.Players[player]
+				build := groupStateBuilder(group, player, reason)
+				if group.Match != nil && g.GetContext().Lie == gogolf.Green && offerConcession(renderer, group, player, lastShots[player], build) {
+					continue
 				}
+				lastShots[player] = takeTurn(renderer, g, lastShots[player], build)
 
-				modifiedClub := ctx.Golfer.GetModifiedClub(ctx.CurrentClub)
-				powerMeter := ui.NewPowerMeter(renderer)
+				if next, _ := group.NextToPlay(); next >= 0 && next != player {
+					prompt := fmt.Sprintf("%s to play - press any key...", group.Players[next].Golfer.Name)
+					renderer.Render(build(g.GetContext(), lastShots[player], prompt))
+					renderer.Terminal.ShowCursor()
+					ui.WaitForAnyKey()
+					renderer.Terminal.HideCursor()
+				}
+			}
 
-				if ctx.CurrentClub.Name == "Putter" {
-					distanceYards := ctx.Ball.Location.Distance(ctx.Hole.HoleLocation).Yards()
-					distanceFeet := float64(distanceYards.Feet())
-					powerMeter.SetPuttingModeWithClubDistance(distanceFeet, float64(modifiedClub.Distance))
-				} else {
-					powerMeter.SetClubDistance(float64(modifiedClub.Distance))
+			hole := group.Players[0].GetCurrentHole()
+			strokes := make([]int, len(group.Players))
+			for i, g := range group.Players {
+				strokes[i] = g.StrokesThisHole()
+			}
+			rewards, err := group.CompleteHole()
+			statusMsg := fmt.Sprintf("Hole %d Complete!", hole.Number)
+			for i, g := range group.Players {
+				if err != nil {
+					statusMsg = err.Error()
+					break
+				}
+				if g.ScoreCard.IsPickedUp(hole) {
+					statusMsg += fmt.Sprintf(" %s picked up after %d", g.Golfer.Name, strokes[i])
+					continue
 				}
-				power := powerMeter.GetPower()
+				statusMsg += fmt.Sprintf(" %s %d (+%d)", g.Golfer.Name, strokes[i], rewards[i])
+			}
+			honour := group.HonourOrder()[0]
+			build := groupStateBuilder(group, honour, game.Honour)
+			state := build(group.Players[honour].GetContext(), lastShots[honour], "Press any key to continue...")
+			state.StatusMsg = statusMsg
+			renderer.Render(state)
+
+			renderer.Terminal.ShowCursor()
+			ui.WaitForAnyKey()
+			renderer.Terminal.HideCursor()
+
+			group.NextHole()
+		}
 
-				result := g.TakeShotWithShape(power, shape)
+		renderer.Terminal.Clear()
+		renderer.Terminal.ShowCursor()
+		fmt.Println("\n=== Round Complete ===")
+		if group.Match != nil {
+			fmt.Printf("Match: %s\n", group.Match.Result())
+		}
+		format := group.ScoringFormat()
+		_, strokePlay := format.(gogolf.StrokePlay)
+		for i, standing := range group.Leaderboard() {
+			result := fmt.Sprintf("%d (%+d)", standing.Strokes, standing.ScoreToPar)
+			if standing.PickedUp > 0 {
+				result = fmt.Sprintf("%d (%+d, %d picked up)", standing.Strokes, standing.ScoreToPar, standing.PickedUp)
+			}
+			if !strokePlay {
+				result = fmt.Sprintf("%s, %d strokes", format.FormatScore(standing.Points), standing.Strokes)
+			}
+			fmt.Printf("%d. %s: %s | Money: %d\n", i+1, standing.Name, result, group.Players[standing.Player].Golfer.Money)
+		}
+		for _, g := range group.Players {
+			if g.Computer == nil {
+				config.recordRound(g)
+			}
+			if posted := postScore(g); posted != "" {
+				fmt.Printf("%s | %s\n", g.Golfer.Name, posted)
+			}
+		}
+		fmt.Printf("Weather: %s\n", group.Players[0].Weather)
+		fmt.Printf("Course: %s\n", group.Players[0].Course.Name)
+		fmt.Printf("Seed: %d (replay with --seed %d)\n", config.seed, config.seed)
+		for _, g := range group.Players {
+			ui.ShowScoreCard(os.Stdout, g.Golfer.Name, g.ScoreCard)
+			if g.Computer == nil {
+				ui.ShowStrokesGained(os.Stdout, g.StrokesGained())
+			}
+		}
+
+		for i := range golfers {
+			golfers[i] = group.Players[i].Golfer
+		}
+		if !showGroupPostRoundMenu(saveManager, config.history, golfers) {
+			return
+		}
+		config.seed++
+		renderer.Terminal.HideCursor()
+	}
+}
 
-				diceRoller := ui.NewDiceRoller(renderer)
-				diceRoller.ShowRoll(result.DiceRolls, result.TargetNumber)
+// playTeamRounds plays rounds for golfers on one team, handing the keyboard to whoever hits next
+// and letting a scramble team choose its ball, until the team chooses to stop
+func playTeamRounds(renderer *ui.Renderer, saveManager *gogolf.SaveManager, config roundConfig, golfers []gogolf.Golfer) {
+	for {
+		team, err := config.newTeam(golfers)
+		if err != nil {
+			renderer.Terminal.ShowCursor()
+			fmt.Printf("Error starting round: %v\n", err)
+			return
+		}
 
-				lastShot = shotResultToDisplay(result)
+		for !team.IsRoundComplete() {
+			team.TeeUp()
+			lastShots := make([]*ui.ShotDisplay, len(team.Players))
+			last := 0
 
-				if result.TapIn {
-					lastShot.Description += " (Tap in)"
+			for !team.IsHoleComplete() {
+				if team.AwaitingChoice() {
+					chooseScrambleBall(renderer, team, lastShots[last])
+					continue
 				}
+				player := team.NextToPlay()
+				g := team.Players[player]
+				build := teamStateBuilder(team, player)
+				lastShots[player] = takeTurn(renderer, g, lastShots[player], build)
+				if err := team.RecordShot(player); err != nil {
+					state := build(team.GetContext(player), lastShots[player], "Press any key to continue...")
+					state.StatusMsg = err.Error()
+					renderer.Render(state)
+					renderer.Terminal.ShowCursor()
+					ui.WaitForAnyKey()
+					renderer.Terminal.HideCursor()
+					continue
+				}
+				last = player
 
-				if result.HoledOut {
-					break
+				if next := team.NextToPlay(); next >= 0 && next != player {
+					prompt := fmt.Sprintf("%s to play - press any key...", team.Players[next].Golfer.Name)
+					renderer.Render(build(team.GetContext(player), lastShots[player], prompt))
+					renderer.Terminal.ShowCursor()
+					ui.WaitForAnyKey()
+					renderer.Terminal.HideCursor()
 				}
 			}
 
-			reward := g.CompleteHole()
-			ctx := g.GetContext()
-			statusMsg := fmt.Sprintf("Hole %d Complete! %d strokes (%+d) | +%d money",
-				ctx.Hole.Number, g.StrokesThisHole(), ctx.ScoreCard.ScoreThisHole(ctx.Hole), reward)
-			state := buildGameState(ctx, lastShot, "Press any key to continue...")
-			state.StatusMsg = statusMsg
+			hole := team.GetCurrentHole()
+			reward := team.CompleteHole()
+			strokes := team.ScoreCard.TotalStrokesThisHole(hole)
+			state := teamStateBuilder(team, last)(team.GetContext(last), lastShots[last], "Press any key to continue...")
+			state.StatusMsg = fmt.Sprintf("Hole %d Complete! %s %d (%+d) | +%d money each",
+				hole.Number, team.Name, strokes, strokes-hole.Par, reward)
 			renderer.Render(state)
 
 			renderer.Terminal.ShowCursor()
 			ui.WaitForAnyKey()
 			renderer.Terminal.HideCursor()
 
-			g.NextHole()
+			team.NextHole()
 		}
 
 		renderer.Terminal.Clear()
 		renderer.Terminal.ShowCursor()
 		fmt.Println("\n=== Round Complete ===")
-		fmt.Printf("Final Score: %d (%+d)\n", g.ScoreCard.TotalStrokes(), g.ScoreCard.Score())
-		fmt.Printf("Money: %d\n\n", g.Golfer.Money)
-		displayPlayerStats(g.Golfer)
+		fmt.Printf("%s (%s): %d (%+d)\n", team.Name, team.Format, team.ScoreCard.TotalStrokes(), team.ScoreCard.Score())
+		if result := points(team.ScoringFormat(), team.Points()); result != "" {
+			fmt.Printf("%s: %s\n", team.ScoringFormat().Name(), result)
+		}
+		for _, g := range team.Players {
+			fmt.Printf("%s | Money: %d\n", g.Golfer.Name, g.Golfer.Money)
+		}
+		fmt.Printf("Weather: %s\n", team.Players[0].Weather)
+		fmt.Printf("Course: %s\n", team.Players[0].Course.Name)
+		fmt.Printf("Seed: %d (replay with --seed %d)\n", config.seed, config.seed)
+		ui.ShowScoreCard(os.Stdout, team.Name, team.ScoreCard)
 
-		if !showPostRoundMenu(saveManager, &g.Golfer) {
+		for i := range golfers {
+			golfers[i] = team.Players[i].Golfer
+		}
+		if !showGroupPostRoundMenu(saveManager, config.history, golfers) {
 			return
 		}
-		g = game.NewFromGolfer(g.Golfer, 3)
+		config.seed++
+		renderer.Terminal.HideCursor()
+	}
+}
+
+// chooseScrambleBall lists where each teammate's shot finished and plays on from the one the team picks
+func chooseScrambleBall(renderer *ui.Renderer, team *game.Team, lastShot *ui.ShotDisplay) {
+	suggested := team.SuggestBall()
+	renderer.Render(teamStateBuilder(team, suggested)(team.GetContext(suggested), lastShot, "Choose the team's ball"))
+	balls := make([]string, len(team.Players))
+	for i, g := range team.Players {
+		ctx := g.GetContext()
+		balls[i] = fmt.Sprintf("%s - %s, %.0f yds", g.Golfer.Name, ctx.Lie, ctx.Ball.Location.Distance(ctx.Hole.HoleLocation).Yards())
+	}
+	team.ChooseBall(ui.NewBallSelector(renderer).SelectBall(balls, suggested))
+}
+
+// teamStateBuilder renders the round from player's side, showing the team's format and card
+func teamStateBuilder(team *game.Team, player int) stateBuilder {
+	return func(ctx game.Context, lastShot *ui.ShotDisplay, promptMsg string) ui.GameState {
+		state := buildGameState(team.GetContext(player), lastShot, promptMsg)
+		state.Turn = fmt.Sprintf("%s for %s", team.Format, team.Name)
+		return state
+	}
+}
+
+// groupStateBuilder renders the round from player's side, showing why it is their turn and the leaderboard
+func groupStateBuilder(group *game.Group, player int, reason game.TurnReason) stateBuilder {
+	return func(ctx game.Context, lastShot *ui.ShotDisplay, promptMsg string) ui.GameState {
+		state := buildGameState(ctx, lastShot, promptMsg)
+		state.Turn = reason.String()
+		if group.Match != nil {
+			state.MatchStatus = group.Match.Status()
+		}
+		for _, standing := range group.Leaderboard() {
+			state.Leaderboard = append(state.Leaderboard, ui.LeaderboardEntry{
+				Name:       standing.Name,
+				Strokes:    standing.Strokes,
+				ScoreToPar: standing.ScoreToPar,
+				Thru:       standing.Thru,
+				PickedUp:   standing.PickedUp,
+				Points:     points(group.ScoringFormat(), standing.Points),
+				Current:    standing.Player == player,
+			})
+		}
+		return state
+	}
+}
+
+// points describes a result in the scoring format, or nothing in stroke play where the score to par says it all
+func points(format gogolf.ScoringFormat, result int) string {
+	if _, strokePlay := format.(gogolf.StrokePlay); strokePlay {
+		return ""
+	}
+	return format.FormatScore(result)
+}
+
+// offerConcession lets the opponent give player's putt or concede the hole before they putt.
+// Computer opponents always make the player putt. It reports whether a concession ended the player's turn.
+func offerConcession(renderer *ui.Renderer, group *game.Group, player int, lastShot *ui.ShotDisplay, build stateBuilder) bool {
+	g := group.Players[player]
+	if group.Players[1-player].Computer != nil {
+		return false
+	}
+	ctx := g.GetContext()
+	opponent := group.Players[1-player].Golfer.Name
+	renderer.Render(build(ctx, lastShot, fmt.Sprintf("%s: give the shot or concede?", opponent)))
+
+	switch ui.NewConcessionSelector(renderer).SelectConcession(ctx.Lie == gogolf.Green) {
+	case ui.GivePutt:
+		return group.ConcedePutt(player) == nil
+	case ui.ConcedeHole:
+		return group.ConcedeHole(1-player) == nil
+	default:
+		return false
+	}
+}
+
+// showGroupPostRoundMenu is the post-round menu for a hot-seat group; each golfer shops and saves on their own
+func showGroupPostRoundMenu(saveManager *gogolf.SaveManager, history *gogolf.HistoryStore, golfers []gogolf.Golfer) bool {
+	proshop := gogolf.NewProShop()
+
+	for {
+		options := []ui.MenuOption{
+			{Label: "Play Another Round", Value: "play"},
+			{Label: "Visit ProShop", Value: "shop"},
+			{Label: "Save Players", Value: "save"},
+			{Label: "Records", Value: "records"},
+			{Label: "Quit", Value: "quit"},
+		}
+
+		choice := ui.ShowMenu("What would you like to do?", options)
+
+		switch options[choice].Value {
+		case "play":
+			return true
+		case "shop":
+			golfer := &golfers[choosePlayer(golfers)]
+			shopUI := ui.NewShopUI(proshop, os.Stdout, os.Stdin)
+			shopUI.Show(golfer)
+		case "save":
+			for _, golfer := range golfers {
+				fmt.Printf("\nSaving %s\n", golfer.Name)
+				showSaveMenu(saveManager, golfer)
+			}
+		case "records":
+			showRecords(history, golfers[choosePlayer(golfers)].Name)
+		case "quit":
+			fmt.Println("Thanks for playing!")
+			return false
+		}
+	}
+}
+
+// showRecords shows the named golfer's records, or everyone's when name is empty
+func showRecords(history *gogolf.HistoryStore, name string) {
+	rounds, err := history.Load()
+	if err != nil {
+		fmt.Printf("Error loading round history: %v\n", err)
+		return
+	}
+	if name != "" {
+		rounds = rounds.ForGolfer(name)
+	}
+	ui.ShowRecords(os.Stdout, rounds)
+}
+
+// choosePlayer asks which golfer in the group is up
+func choosePlayer(golfers []gogolf.Golfer) int {
+	options := make([]ui.MenuOption, len(golfers))
+	for i, golfer := range golfers {
+		options[i] = ui.MenuOption{Label: golfer.Name, Value: golfer.Name}
 	}
+	return ui.ShowMenu("Which player?", options)
 }
 
-func showPostRoundMenu(saveManager *gogolf.SaveManager, golfer *gogolf.Golfer) bool {
+func showPostRoundMenu(saveManager *gogolf.SaveManager, history *gogolf.HistoryStore, golfer *gogolf.Golfer) bool {
 	proshop := gogolf.NewProShop()
 
 	for {
@@ -305,6 +1309,7 @@ func showPostRoundMenu(saveManager *gogolf.SaveManager, golfer *gogolf.Golfer) b
 			{Label: "Play Another Round", Value: "play"},
 			{Label: "Visit ProShop", Value: "shop"},
 			{Label: "Save Game", Value: "save"},
+			{Label: "Records", Value: "records"},
 			{Label: "Quit", Value: "quit"},
 		}
 
@@ -318,6 +1323,8 @@ func showPostRoundMenu(saveManager *gogolf.SaveManager, golfer *gogolf.Golfer) b
 			shopUI.Show(golfer)
 		case "save":
 			showSaveMenu(saveManager, *golfer)
+		case "records":
+			showRecords(history, golfer.Name)
 		case "quit":
 			fmt.Println("Thanks for playing!")
 			return false
@@ -17,15 +17,16 @@ import (
 type Hole struct {
 	Number       int
 	Par          int
+	StrokeIndex  int // Ranks the hole's difficulty, 1 for the hardest; 0 when unrated
 	Distance     Yard
 	Boundary     Size
+	TeeLocation  Point
 	HoleLocation Point
 	Grid         *CourseGrid // Course grid for lie detection
+	Obstacles    []Obstacle  // Trees and other tall hazards the ball can strike
 }
 
 func (h Hole) DetectHoleOut(b GolfBall, bPath Vector) bool {
-	// eventually when we have 'carry' and 'roll' paths, we will need to make sure
-	// it was the roll path, or the carries endpoint that hits the hole
 	directHit, distanceFromHole := b.CheckForCollision(bPath, h.HoleLocation)
 	hitAndStoppedInHole := directHit && b.Location.Distance(h.HoleLocation) <= Foot(16).Units()
 	closeEnough := distanceFromHole <= Unit(2) && b.Location.Distance(h.HoleLocation) <= Yard(1).Units()
@@ -33,6 +34,19 @@ func (h Hole) DetectHoleOut(b GolfBall, bPath Vector) bool {
 
 }
 
+// DetectHoleOutOnFlight checks whether a shot finished in the cup.
+// Only the carry's endpoint can dunk the ball; after that it is the roll path that has to find the hole.
+func (h Hole) DetectHoleOutOnFlight(f Flight) bool {
+	if f.Landing.Distance(h.HoleLocation) < 1 {
+		return true
+	}
+	if len(f.RollPath) > 1 {
+		return h.DetectHoleOutOnPath(f.RollPath)
+	}
+	rolling := GolfBall{PrevLocation: f.Landing, Location: f.Rest}
+	return h.DetectHoleOut(rolling, f.Roll())
+}
+
 func (h Hole) DetectTapIn(b GolfBall) bool {
 	return b.Location.Distance(h.HoleLocation) <= Foot(4).Units()
 }
@@ -76,7 +90,14 @@ func (h Hole) String() string {
 }
 
 type Course struct {
+	Name  string
 	Holes []Hole
+	// Rating and Slope grade the course for handicapping; zero when the course is unrated
+	Rating float64
+	Slope  int
+	// Definition is what the course was built from, kept so a round in progress can be saved;
+	// nil for a course put together by hand
+	Definition *CourseDefinition
 }
 
 func (c Course) Par() (par int) {
@@ -97,6 +118,7 @@ func (c Course) ParUpToHole(holeNumber int) (par int) {
 
 // GenerateSimpleCourse creates a course with grid-based lie detection
 // This is a simplified version that sets up basic fairway/rough/green patterns
+// Rounds are played on GenerateCourse layouts; this flat template is kept as a predictable fixture
 func GenerateSimpleCourse(holeCount int) (Course, ScoreCard) {
 	holes := []Hole{}
 
@@ -137,9 +159,5 @@ func GenerateSimpleCourse(holeCount int) (Course, ScoreCard) {
 	}
 
 	course := Course{Holes: holes}
-	scoreCard := ScoreCard{
-		Course: course,
-		Scores: map[int]int{},
-	}
-	return course, scoreCard
+	return course, NewScoreCard(course)
 }
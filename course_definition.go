@@ -0,0 +1,556 @@
+package gogolf
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+)
+
+// CurrentCourseVersion is the course file format version written and accepted by this build
+const CurrentCourseVersion = 1
+
+// Region shapes supported in course files
+const (
+	RegionRectangle = "rectangle"
+	RegionEllipse   = "ellipse"
+	RegionPolygon   = "polygon"
+)
+
+// CourseDefinition is the on-disk description of a course.
+// All positions and sizes are in yards, measured from the bottom-left corner of each hole's grid.
+type CourseDefinition struct {
+	Version int              `json:"version"`
+	Name    string           `json:"name"`
+	Holes   []HoleDefinition `json:"holes"`
+	// Rating and Slope grade the course for handicapping; left out, the course is rated at par with the standard slope
+	Rating float64 `json:"rating,omitempty"`
+	Slope  int     `json:"slope,omitempty"`
+}
+
+// HoleDefinition describes a single hole: its par, tee and pin, grid and painted lie regions
+type HoleDefinition struct {
+	Number     int                  `json:"number"`
+	Par        int                  `json:"par"`
+	Tee        PointDefinition      `json:"tee"`
+	Pin        PointDefinition      `json:"pin"`
+	Grid       GridDefinition       `json:"grid"`
+	DefaultLie string               `json:"default_lie,omitempty"`
+	Regions    []RegionDefinition   `json:"regions,omitempty"`
+	Obstacles  []ObstacleDefinition `json:"obstacles,omitempty"`
+	Green      *GreenDefinition     `json:"green,omitempty"`
+	Elevation  *ElevationDefinition `json:"elevation,omitempty"`
+	// StrokeIndex ranks the hole's difficulty on the course, 1 for the hardest; 0 leaves it unrated
+	StrokeIndex int `json:"stroke_index,omitempty"`
+}
+
+// ElevationDefinition gives a hole its height map, in feet. The ground rises or falls evenly from
+// the tee's height to the pin's, and hills (or hollows, with a negative height) are laid on top.
+type ElevationDefinition struct {
+	Tee   float64          `json:"tee"`
+	Green float64          `json:"green"`
+	Hills []HillDefinition `json:"hills,omitempty"`
+}
+
+// HillDefinition is a smooth rise over an ellipse, Height feet at its center and level at its edge
+type HillDefinition struct {
+	Center  PointDefinition `json:"center"`
+	RadiusX float64         `json:"radius_x"`
+	RadiusY float64         `json:"radius_y"`
+	Height  float64         `json:"height"`
+}
+
+// maximumElevation bounds, in feet, the heights allowed in a course file
+const maximumElevation = 200.0
+
+// GreenDefinition sets the speed and contour of a hole's putting surface.
+// Slope is the downhill tilt of the whole green in percent grade. Crown raises the green toward
+// Center, which defaults to the pin, so every cell also falls away from it by up to Crown percent.
+type GreenDefinition struct {
+	Stimp  float64          `json:"stimp,omitempty"`
+	Slope  SlopeDefinition  `json:"slope"`
+	Crown  float64          `json:"crown,omitempty"`
+	Center *PointDefinition `json:"center,omitempty"`
+}
+
+// SlopeDefinition is a downhill direction and steepness in percent grade
+type SlopeDefinition struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+// Limits on green contours in course files
+const (
+	minimumStimp = 6.0
+	maximumStimp = 15.0
+	maximumGrade = 6.0
+	maximumCrown = 4.0
+	// crownRadius is how far from the crown, in yards, the green reaches its full fall-away
+	crownRadius = 10.0
+)
+
+// GridDefinition sets the size and resolution of a hole's CourseGrid
+type GridDefinition struct {
+	Width    float64 `json:"width"`
+	Length   float64 `json:"length"`
+	CellSize float64 `json:"cell_size"`
+}
+
+// PointDefinition is a position on a hole in yards
+type PointDefinition struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+// RegionDefinition paints a lie over a shape. Later regions are painted over earlier ones.
+// Rectangles use Min and Max, ellipses use Center, RadiusX and RadiusY, polygons use Points.
+type RegionDefinition struct {
+	Lie     string            `json:"lie"`
+	Shape   string            `json:"shape"`
+	Min     *PointDefinition  `json:"min,omitempty"`
+	Max     *PointDefinition  `json:"max,omitempty"`
+	Center  *PointDefinition  `json:"center,omitempty"`
+	RadiusX float64           `json:"radius_x,omitempty"`
+	RadiusY float64           `json:"radius_y,omitempty"`
+	Points  []PointDefinition `json:"points,omitempty"`
+}
+
+// ObstacleDefinition places a tree, tree line or stake on a hole.
+// Radius is in yards and Height in feet; End defaults to Start for a single tree or stake.
+type ObstacleDefinition struct {
+	Kind   string           `json:"kind"`
+	Name   string           `json:"name,omitempty"`
+	Start  PointDefinition  `json:"start"`
+	End    *PointDefinition `json:"end,omitempty"`
+	Radius float64          `json:"radius"`
+	Height float64          `json:"height"`
+}
+
+// CourseDefinitionError reports an invalid field in a course definition.
+// Hole is the 1-based position of the hole in the file, or 0 for course-level fields,
+// and Number is the number the hole is given there.
+type CourseDefinitionError struct {
+	Hole    int
+	Number  int
+	Field   string
+	Message string
+}
+
+func (e *CourseDefinitionError) Error() string {
+	switch {
+	case e.Hole == 0:
+		return fmt.Sprintf("course: %s: %s", e.Field, e.Message)
+	case e.Number == e.Hole:
+		return fmt.Sprintf("hole %d: %s: %s", e.Number, e.Field, e.Message)
+	default:
+		return fmt.Sprintf("hole %d (position %d in the file): %s: %s", e.Number, e.Hole, e.Field, e.Message)
+	}
+}
+
+func holeError(hole int, field, format string, args ...interface{}) *CourseDefinitionError {
+	return &CourseDefinitionError{Hole: hole, Field: field, Message: fmt.Sprintf(format, args...)}
+}
+
+// Units converts the yard position to a point in game units
+func (p PointDefinition) Units() Point {
+	return Point{X: int(Yard(p.X).Units()), Y: int(Yard(p.Y).Units())}
+}
+
+func pointDefinitionFromUnits(p Point) PointDefinition {
+	return PointDefinition{X: float64(Unit(p.X).Yards()), Y: float64(Unit(p.Y).Yards())}
+}
+
+// LoadCourse reads, validates and builds a course from a course file
+func LoadCourse(path string) (Course, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Course{}, fmt.Errorf("failed to read course file: %w", err)
+	}
+
+	definition, err := ParseCourseDefinition(data)
+	if err != nil {
+		return Course{}, err
+	}
+
+	return definition.Build()
+}
+
+// ParseCourseDefinition decodes a course file, rejecting unknown fields
+func ParseCourseDefinition(data []byte) (CourseDefinition, error) {
+	var definition CourseDefinition
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&definition); err != nil {
+		return CourseDefinition{}, fmt.Errorf("failed to parse course file: %w", err)
+	}
+	return definition, nil
+}
+
+// Validate checks the definition and returns the first problem found
+func (d CourseDefinition) Validate() error {
+	if d.Version != CurrentCourseVersion {
+		return &CourseDefinitionError{Field: "version", Message: fmt.Sprintf("unsupported version %d, want %d", d.Version, CurrentCourseVersion)}
+	}
+	if len(d.Holes) == 0 {
+		return &CourseDefinitionError{Field: "holes", Message: "course must have at least one hole"}
+	}
+	if d.Rating < 0 {
+		return &CourseDefinitionError{Field: "rating", Message: fmt.Sprintf("must be positive, got %.1f", d.Rating)}
+	}
+	if d.Slope != 0 && (d.Slope < MinimumSlope || d.Slope > MaximumSlope) {
+		return &CourseDefinitionError{Field: "slope", Message: fmt.Sprintf("must be between %d and %d, got %d", MinimumSlope, MaximumSlope, d.Slope)}
+	}
+
+	seen := map[int]bool{}
+	strokeIndexes := map[int]bool{}
+	for i, hole := range d.Holes {
+		if seen[hole.Number] {
+			err := holeError(i+1, "number", "duplicate hole number %d", hole.Number)
+			err.Number = hole.Number
+			return err
+		}
+		seen[hole.Number] = true
+
+		if hole.StrokeIndex != 0 {
+			if hole.StrokeIndex < 0 || hole.StrokeIndex > len(d.Holes) {
+				err := holeError(i+1, "stroke_index", "must be between 1 and %d, got %d", len(d.Holes), hole.StrokeIndex)
+				err.Number = hole.Number
+				return err
+			}
+			if strokeIndexes[hole.StrokeIndex] {
+				err := holeError(i+1, "stroke_index", "duplicate stroke index %d", hole.StrokeIndex)
+				err.Number = hole.Number
+				return err
+			}
+			strokeIndexes[hole.StrokeIndex] = true
+		}
+
+		if err := hole.validate(i + 1); err != nil {
+			err.Number = hole.Number
+			return err
+		}
+	}
+	return nil
+}
+
+func (h HoleDefinition) validate(position int) *CourseDefinitionError {
+	if h.Number < 1 {
+		return holeError(position, "number", "must be positive, got %d", h.Number)
+	}
+	if h.Par < 3 || h.Par > 6 {
+		return holeError(position, "par", "must be between 3 and 6, got %d", h.Par)
+	}
+	if h.Grid.Width <= 0 {
+		return holeError(position, "grid.width", "must be positive")
+	}
+	if h.Grid.Length <= 0 {
+		return holeError(position, "grid.length", "must be positive")
+	}
+	if h.Grid.CellSize <= 0 || h.Grid.CellSize > h.Grid.Width || h.Grid.CellSize > h.Grid.Length {
+		return holeError(position, "grid.cell_size", "must be positive and fit within the grid")
+	}
+	if !h.Grid.contains(h.Tee) {
+		return holeError(position, "tee", "(%.1f, %.1f) is outside the grid", h.Tee.X, h.Tee.Y)
+	}
+	if !h.Grid.contains(h.Pin) {
+		return holeError(position, "pin", "(%.1f, %.1f) is outside the grid", h.Pin.X, h.Pin.Y)
+	}
+	if h.DefaultLie != "" {
+		if _, err := ParseLieType(h.DefaultLie); err != nil {
+			return holeError(position, "default_lie", "%v", err)
+		}
+	}
+
+	for i, region := range h.Regions {
+		if err := region.validate(); err != nil {
+			return holeError(position, fmt.Sprintf("regions[%d].%s", i, err.Field), "%s", err.Message)
+		}
+	}
+	for i, obstacle := range h.Obstacles {
+		if err := obstacle.validate(h.Grid); err != nil {
+			return holeError(position, fmt.Sprintf("obstacles[%d].%s", i, err.Field), "%s", err.Message)
+		}
+	}
+	if h.Green != nil {
+		if err := h.Green.validate(h.Grid); err != nil {
+			return holeError(position, "green."+err.Field, "%s", err.Message)
+		}
+	}
+	if h.Elevation != nil {
+		if err := h.Elevation.validate(h.Grid); err != nil {
+			return holeError(position, "elevation."+err.Field, "%s", err.Message)
+		}
+	}
+	return nil
+}
+
+func (e ElevationDefinition) validate(grid GridDefinition) *CourseDefinitionError {
+	if math.Abs(e.Tee) > maximumElevation {
+		return &CourseDefinitionError{Field: "tee", Message: fmt.Sprintf("must be within %.0f feet, got %.1f", maximumElevation, e.Tee)}
+	}
+	if math.Abs(e.Green) > maximumElevation {
+		return &CourseDefinitionError{Field: "green", Message: fmt.Sprintf("must be within %.0f feet, got %.1f", maximumElevation, e.Green)}
+	}
+	for i, hill := range e.Hills {
+		field := fmt.Sprintf("hills[%d].", i)
+		if !grid.contains(hill.Center) {
+			return &CourseDefinitionError{Field: field + "center", Message: fmt.Sprintf("(%.1f, %.1f) is outside the grid", hill.Center.X, hill.Center.Y)}
+		}
+		if hill.RadiusX <= 0 || hill.RadiusY <= 0 {
+			return &CourseDefinitionError{Field: field + "radius_x", Message: "hill radii must be positive"}
+		}
+		if math.Abs(hill.Height) > maximumElevation {
+			return &CourseDefinitionError{Field: field + "height", Message: fmt.Sprintf("must be within %.0f feet, got %.1f", maximumElevation, hill.Height)}
+		}
+	}
+	return nil
+}
+
+// heightAt returns the ground height at a yard position on a hole played from tee to pin
+func (e ElevationDefinition) heightAt(p, tee, pin PointDefinition) float64 {
+	line := Vector{X: pin.X - tee.X, Y: pin.Y - tee.Y}
+	progress := 0.0
+	if length := line.Dot(line); length > 0 {
+		progress = math.Max(0, math.Min(1, Vector{X: p.X - tee.X, Y: p.Y - tee.Y}.Dot(line)/length))
+	}
+	height := e.Tee + (e.Green-e.Tee)*progress
+
+	for _, hill := range e.Hills {
+		dx := (p.X - hill.Center.X) / hill.RadiusX
+		dy := (p.Y - hill.Center.Y) / hill.RadiusY
+		if distance := math.Sqrt(dx*dx + dy*dy); distance < 1 {
+			height += hill.Height * (1 + math.Cos(math.Pi*distance)) / 2
+		}
+	}
+	return height
+}
+
+func (g GreenDefinition) validate(grid GridDefinition) *CourseDefinitionError {
+	if g.Stimp != 0 && (g.Stimp < minimumStimp || g.Stimp > maximumStimp) {
+		return &CourseDefinitionError{Field: "stimp", Message: fmt.Sprintf("must be between %.0f and %.0f, got %.1f", minimumStimp, maximumStimp, g.Stimp)}
+	}
+	if grade := math.Hypot(g.Slope.X, g.Slope.Y); grade > maximumGrade {
+		return &CourseDefinitionError{Field: "slope", Message: fmt.Sprintf("grade must be at most %.0f%%, got %.1f%%", maximumGrade, grade)}
+	}
+	if g.Crown < 0 || g.Crown > maximumCrown {
+		return &CourseDefinitionError{Field: "crown", Message: fmt.Sprintf("must be between 0 and %.0f, got %.1f", maximumCrown, g.Crown)}
+	}
+	if g.Center != nil && !grid.contains(*g.Center) {
+		return &CourseDefinitionError{Field: "center", Message: fmt.Sprintf("(%.1f, %.1f) is outside the grid", g.Center.X, g.Center.Y)}
+	}
+	return nil
+}
+
+// slopeAt returns the downhill slope at a yard position: the green's tilt plus the fall-away from its crown
+func (g GreenDefinition) slopeAt(p, pin PointDefinition) Vector {
+	slope := Vector{X: g.Slope.X, Y: g.Slope.Y}
+	if g.Crown == 0 {
+		return slope
+	}
+	center := pin
+	if g.Center != nil {
+		center = *g.Center
+	}
+	away := Vector{X: p.X - center.X, Y: p.Y - center.Y}
+	distance := away.Magnitude()
+	if distance == 0 {
+		return slope
+	}
+	grade := g.Crown * math.Min(distance/crownRadius, 1)
+	return Vector{X: slope.X + away.X/distance*grade, Y: slope.Y + away.Y/distance*grade}
+}
+
+func (o ObstacleDefinition) validate(grid GridDefinition) *CourseDefinitionError {
+	if _, err := ParseObstacleKind(o.Kind); err != nil {
+		return &CourseDefinitionError{Field: "kind", Message: err.Error()}
+	}
+	if !grid.contains(o.Start) {
+		return &CourseDefinitionError{Field: "start", Message: fmt.Sprintf("(%.1f, %.1f) is outside the grid", o.Start.X, o.Start.Y)}
+	}
+	if o.End != nil && !grid.contains(*o.End) {
+		return &CourseDefinitionError{Field: "end", Message: fmt.Sprintf("(%.1f, %.1f) is outside the grid", o.End.X, o.End.Y)}
+	}
+	if o.Radius <= 0 {
+		return &CourseDefinitionError{Field: "radius", Message: "must be positive"}
+	}
+	if o.Height <= 0 {
+		return &CourseDefinitionError{Field: "height", Message: "must be positive"}
+	}
+	return nil
+}
+
+func (o ObstacleDefinition) build() Obstacle {
+	kind, _ := ParseObstacleKind(o.Kind)
+	end := o.Start
+	if o.End != nil {
+		end = *o.End
+	}
+	name := o.Name
+	if name == "" {
+		name = kind.String()
+	}
+	return Obstacle{
+		Kind:   kind,
+		Name:   name,
+		Start:  o.Start.Units(),
+		End:    end.Units(),
+		Radius: Yard(o.Radius).Units(),
+		Height: Foot(o.Height),
+	}
+}
+
+func (g GridDefinition) contains(p PointDefinition) bool {
+	return p.X >= 0 && p.Y >= 0 && p.X < g.Width && p.Y < g.Length
+}
+
+func (r RegionDefinition) validate() *CourseDefinitionError {
+	if _, err := ParseLieType(r.Lie); err != nil {
+		return &CourseDefinitionError{Field: "lie", Message: err.Error()}
+	}
+
+	switch r.Shape {
+	case RegionRectangle:
+		if r.Min == nil || r.Max == nil {
+			return &CourseDefinitionError{Field: "min", Message: "rectangle needs min and max"}
+		}
+		if r.Min.X >= r.Max.X || r.Min.Y >= r.Max.Y {
+			return &CourseDefinitionError{Field: "max", Message: "must be above and right of min"}
+		}
+	case RegionEllipse:
+		if r.Center == nil {
+			return &CourseDefinitionError{Field: "center", Message: "ellipse needs a center"}
+		}
+		if r.RadiusX <= 0 || r.RadiusY <= 0 {
+			return &CourseDefinitionError{Field: "radius_x", Message: "ellipse radii must be positive"}
+		}
+	case RegionPolygon:
+		if len(r.Points) < 3 {
+			return &CourseDefinitionError{Field: "points", Message: fmt.Sprintf("polygon needs at least 3 points, got %d", len(r.Points))}
+		}
+	default:
+		return &CourseDefinitionError{Field: "shape", Message: fmt.Sprintf("unknown shape %q", r.Shape)}
+	}
+	return nil
+}
+
+// Contains reports whether a yard position falls inside the region
+func (r RegionDefinition) Contains(p PointDefinition) bool {
+	switch r.Shape {
+	case RegionRectangle:
+		return p.X >= r.Min.X && p.X <= r.Max.X && p.Y >= r.Min.Y && p.Y <= r.Max.Y
+	case RegionEllipse:
+		dx := (p.X - r.Center.X) / r.RadiusX
+		dy := (p.Y - r.Center.Y) / r.RadiusY
+		return dx*dx+dy*dy <= 1
+	case RegionPolygon:
+		return polygonContains(r.Points, p)
+	}
+	return false
+}
+
+// polygonContains uses ray casting: a point is inside if a ray from it crosses an odd number of edges
+func polygonContains(points []PointDefinition, p PointDefinition) bool {
+	inside := false
+	j := len(points) - 1
+	for i := range points {
+		a, b := points[i], points[j]
+		if (a.Y > p.Y) != (b.Y > p.Y) {
+			crossX := a.X + (p.Y-a.Y)/(b.Y-a.Y)*(b.X-a.X)
+			if p.X < crossX {
+				inside = !inside
+			}
+		}
+		j = i
+	}
+	return inside
+}
+
+// Build validates the definition and rasterises each hole into a Course
+func (d CourseDefinition) Build() (Course, error) {
+	if err := d.Validate(); err != nil {
+		return Course{}, err
+	}
+
+	holes := make([]Hole, 0, len(d.Holes))
+	for _, holeDefinition := range d.Holes {
+		holes = append(holes, holeDefinition.build())
+	}
+	return Course{Name: d.Name, Holes: holes, Rating: d.Rating, Slope: d.Slope, Definition: &d}, nil
+}
+
+func (h HoleDefinition) build() Hole {
+	width := Yard(h.Grid.Width)
+	length := Yard(h.Grid.Length)
+	tee := h.Tee.Units()
+	pin := h.Pin.Units()
+
+	hole := NewHoleWithGrid(h.Number, h.Par, pin, Size{Width: width.Units(), Length: length.Units()}, width, length, Yard(h.Grid.CellSize))
+	hole.TeeLocation = tee
+	hole.Distance = tee.Distance(pin).Yards()
+	hole.StrokeIndex = h.StrokeIndex
+
+	if h.DefaultLie != "" {
+		lie, _ := ParseLieType(h.DefaultLie)
+		hole.Grid.Fill(lie)
+	}
+	for _, region := range h.Regions {
+		lie, _ := ParseLieType(region.Lie)
+		hole.Grid.PaintRegion(region, lie)
+	}
+	for _, obstacle := range h.Obstacles {
+		hole.Obstacles = append(hole.Obstacles, obstacle.build())
+	}
+	if h.Green != nil {
+		hole.Grid.ShapeGreen(*h.Green, h.Pin)
+	}
+	if h.Elevation != nil {
+		hole.Grid.ShapeTerrain(*h.Elevation, h.Tee, h.Pin)
+	}
+	return *hole
+}
+
+// Fill sets every cell in the grid to the given lie
+func (g *CourseGrid) Fill(lie LieType) {
+	for i := range g.Cells {
+		for j := range g.Cells[i] {
+			g.Cells[i][j].Lie = lie
+		}
+	}
+}
+
+// ShapeGreen sets the green speed and gives every green cell its slope
+func (g *CourseGrid) ShapeGreen(green GreenDefinition, pin PointDefinition) {
+	if green.Stimp > 0 {
+		g.Stimp = green.Stimp
+	}
+	for i := range g.Cells {
+		for j := range g.Cells[i] {
+			cell := &g.Cells[i][j]
+			if cell.Lie == Green {
+				cell.Slope = green.slopeAt(pointDefinitionFromUnits(cell.Position), pin)
+			}
+		}
+	}
+}
+
+// ShapeTerrain sets the height of every cell from the hole's elevation definition
+func (g *CourseGrid) ShapeTerrain(elevation ElevationDefinition, tee, pin PointDefinition) {
+	for i := range g.Cells {
+		for j := range g.Cells[i] {
+			cell := &g.Cells[i][j]
+			cell.Elevation = Foot(elevation.heightAt(pointDefinitionFromUnits(cell.Position), tee, pin))
+		}
+	}
+}
+
+// PaintRegion sets the lie of every cell whose center falls inside the region
+func (g *CourseGrid) PaintRegion(region RegionDefinition, lie LieType) {
+	for i := range g.Cells {
+		for j := range g.Cells[i] {
+			if region.Contains(pointDefinitionFromUnits(g.Cells[i][j].Position)) {
+				g.Cells[i][j].Lie = lie
+			}
+		}
+	}
+}
@@ -0,0 +1,308 @@
+package gogolf
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func validHoleDefinition() HoleDefinition {
+	return HoleDefinition{
+		Number:     1,
+		Par:        4,
+		Tee:        PointDefinition{X: 25, Y: 5},
+		Pin:        PointDefinition{X: 25, Y: 290},
+		Grid:       GridDefinition{Width: 50, Length: 300, CellSize: 5},
+		DefaultLie: "rough",
+		Regions: []RegionDefinition{
+			{Lie: "fairway", Shape: RegionRectangle, Min: &PointDefinition{X: 10, Y: 20}, Max: &PointDefinition{X: 40, Y: 270}},
+			{Lie: "green", Shape: RegionEllipse, Center: &PointDefinition{X: 25, Y: 288}, RadiusX: 10, RadiusY: 10},
+			{Lie: "bunker", Shape: RegionPolygon, Points: []PointDefinition{{X: 0, Y: 150}, {X: 8, Y: 150}, {X: 8, Y: 170}}},
+		},
+	}
+}
+
+func TestParseLieType(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected LieType
+	}{
+		{"fairway", Fairway},
+		{"First Cut", FirstCut},
+		{"first_cut", FirstCut},
+		{"deep-rough", DeepRough},
+		{"PENALTY_AREA", PenaltyArea},
+	}
+
+	for _, tt := range tests {
+		lie, err := ParseLieType(tt.name)
+		if err != nil {
+			t.Errorf("ParseLieType(%q) returned error: %v", tt.name, err)
+		}
+		if lie != tt.expected {
+			t.Errorf("ParseLieType(%q) = %v, want %v", tt.name, lie, tt.expected)
+		}
+	}
+
+	if _, err := ParseLieType("lava"); err == nil {
+		t.Error("expected error for unknown lie")
+	}
+}
+
+func TestCourseDefinition_BuildRasterisesRegions(t *testing.T) {
+	definition := CourseDefinition{Version: CurrentCourseVersion, Name: "Test", Holes: []HoleDefinition{validHoleDefinition()}}
+
+	course, err := definition.Build()
+	if err != nil {
+		t.Fatalf("Build returned error: %v", err)
+	}
+
+	if course.Name != "Test" {
+		t.Errorf("course name = %q, want Test", course.Name)
+	}
+
+	hole := course.Holes[0]
+	tests := []struct {
+		name     string
+		position PointDefinition
+		expected LieType
+	}{
+		{"default lie", PointDefinition{X: 2, Y: 50}, Rough},
+		{"rectangle", PointDefinition{X: 25, Y: 100}, Fairway},
+		{"ellipse", PointDefinition{X: 25, Y: 288}, Green},
+		{"polygon", PointDefinition{X: 6, Y: 155}, Bunker},
+		{"off grid", PointDefinition{X: 60, Y: 100}, PenaltyArea},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lie := hole.GetLieAtPosition(tt.position.Units())
+			if lie != tt.expected {
+				t.Errorf("lie at %+v = %v, want %v", tt.position, lie, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCourseDefinition_BuildSetsTeeAndDistance(t *testing.T) {
+	definition := CourseDefinition{Version: CurrentCourseVersion, Holes: []HoleDefinition{validHoleDefinition()}}
+
+	course, err := definition.Build()
+	if err != nil {
+		t.Fatalf("Build returned error: %v", err)
+	}
+
+	hole := course.Holes[0]
+	if hole.TeeLocation != (PointDefinition{X: 25, Y: 5}).Units() {
+		t.Errorf("tee location = %+v, want tee from definition", hole.TeeLocation)
+	}
+	if hole.Distance < 284 || hole.Distance > 286 {
+		t.Errorf("hole distance = %.1f, want ~285 yards from tee to pin", hole.Distance)
+	}
+}
+
+func TestCourseDefinition_ValidationNamesHoleAndField(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(h *HoleDefinition)
+		field  string
+	}{
+		{"bad par", func(h *HoleDefinition) { h.Par = 9 }, "par"},
+		{"pin off grid", func(h *HoleDefinition) { h.Pin = PointDefinition{X: 25, Y: 400} }, "pin"},
+		{"tee off grid", func(h *HoleDefinition) { h.Tee = PointDefinition{X: -1, Y: 0} }, "tee"},
+		{"zero cell size", func(h *HoleDefinition) { h.Grid.CellSize = 0 }, "grid.cell_size"},
+		{"unknown default lie", func(h *HoleDefinition) { h.DefaultLie = "lava" }, "default_lie"},
+		{"unknown region lie", func(h *HoleDefinition) { h.Regions[1].Lie = "lava" }, "regions[1].lie"},
+		{"unknown shape", func(h *HoleDefinition) { h.Regions[0].Shape = "star" }, "regions[0].shape"},
+		{"short polygon", func(h *HoleDefinition) { h.Regions[2].Points = h.Regions[2].Points[:2] }, "regions[2].points"},
+		{"slow green", func(h *HoleDefinition) { h.Green = &GreenDefinition{Stimp: 3} }, "green.stimp"},
+		{"steep green", func(h *HoleDefinition) { h.Green = &GreenDefinition{Slope: SlopeDefinition{X: 5, Y: 5}} }, "green.slope"},
+		{"tee too high", func(h *HoleDefinition) { h.Elevation = &ElevationDefinition{Tee: 500} }, "elevation.tee"},
+		{"flat hill", func(h *HoleDefinition) {
+			h.Elevation = &ElevationDefinition{Hills: []HillDefinition{{Center: PointDefinition{X: 25, Y: 150}, RadiusX: 0, RadiusY: 10, Height: 5}}}
+		}, "elevation.hills[0].radius_x"},
+		{"crown off grid", func(h *HoleDefinition) { h.Green = &GreenDefinition{Crown: 1, Center: &PointDefinition{X: 90, Y: 0}} }, "green.center"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			second := validHoleDefinition()
+			second.Number = 2
+			tt.modify(&second)
+			definition := CourseDefinition{Version: CurrentCourseVersion, Holes: []HoleDefinition{validHoleDefinition(), second}}
+
+			_, err := definition.Build()
+			var defErr *CourseDefinitionError
+			if !errors.As(err, &defErr) {
+				t.Fatalf("expected CourseDefinitionError, got %v", err)
+			}
+			if defErr.Hole != 2 {
+				t.Errorf("error hole = %d, want 2", defErr.Hole)
+			}
+			if defErr.Field != tt.field {
+				t.Errorf("error field = %q, want %q", defErr.Field, tt.field)
+			}
+			if !strings.HasPrefix(err.Error(), "hole 2: "+tt.field) {
+				t.Errorf("error message %q should name the hole and field", err.Error())
+			}
+		})
+	}
+}
+
+func TestCourseDefinition_BuildShapesGreen(t *testing.T) {
+	hole := validHoleDefinition()
+	hole.Green = &GreenDefinition{Stimp: 12, Slope: SlopeDefinition{X: 1.5, Y: 0}, Crown: 2}
+	definition := CourseDefinition{Version: CurrentCourseVersion, Holes: []HoleDefinition{hole}}
+
+	course, err := definition.Build()
+	if err != nil {
+		t.Fatalf("Build returned error: %v", err)
+	}
+	built := course.Holes[0]
+
+	if built.GreenSpeed() != 12 {
+		t.Errorf("GreenSpeed() = %v, want 12", built.GreenSpeed())
+	}
+	if left, right := built.SlopeAt(yards(17, 290)), built.SlopeAt(yards(33, 290)); left.X >= 1.5 || right.X <= 1.5 {
+		t.Errorf("crown should fall away either side of the pin: left %+v, right %+v", left, right)
+	}
+	if slope := built.SlopeAt(yards(25, 280)); slope.Y >= 0 {
+		t.Errorf("slope short of the crown = %+v, should fall away toward the tee", slope)
+	}
+	if slope := built.SlopeAt(yards(25, 150)); slope != (Vector{}) {
+		t.Errorf("slope off the green = %+v, want flat", slope)
+	}
+}
+
+func TestCourseDefinition_BuildShapesTerrain(t *testing.T) {
+	hole := validHoleDefinition()
+	hole.Elevation = &ElevationDefinition{
+		Tee:   30,
+		Green: 0,
+		Hills: []HillDefinition{{Center: PointDefinition{X: 40, Y: 150}, RadiusX: 10, RadiusY: 10, Height: 8}},
+	}
+	definition := CourseDefinition{Version: CurrentCourseVersion, Holes: []HoleDefinition{hole}}
+
+	course, err := definition.Build()
+	if err != nil {
+		t.Fatalf("Build returned error: %v", err)
+	}
+	built := course.Holes[0]
+
+	tee, pin := built.ElevationAt(built.TeeLocation), built.ElevationAt(built.HoleLocation)
+	if tee <= pin {
+		t.Errorf("tee should sit above the green: tee %.1f ft, pin %.1f ft", tee, pin)
+	}
+	halfway := built.ElevationAt(yards(25, 150))
+	if halfway >= tee || halfway <= pin {
+		t.Errorf("ground halfway should fall between tee and green, got %.1f ft", halfway)
+	}
+	if hill := built.ElevationAt(yards(40, 150)); hill < halfway+4 {
+		t.Errorf("hill should stand well above the surrounding ground: hill %.1f, ground %.1f", hill, halfway)
+	}
+}
+
+func TestCourseDefinition_ValidationRejectsDuplicateHoles(t *testing.T) {
+	definition := CourseDefinition{Version: CurrentCourseVersion, Holes: []HoleDefinition{validHoleDefinition(), validHoleDefinition()}}
+
+	err := definition.Validate()
+	if err == nil || !strings.Contains(err.Error(), "hole 1 (position 2 in the file): number") {
+		t.Errorf("expected duplicate number error on the second hole 1, got %v", err)
+	}
+}
+
+func TestCourseDefinition_ValidationNamesHoleByNumber(t *testing.T) {
+	tenth := validHoleDefinition()
+	tenth.Number = 10
+	eleventh := validHoleDefinition()
+	eleventh.Number = 11
+	eleventh.Par = 9
+	definition := CourseDefinition{Version: CurrentCourseVersion, Holes: []HoleDefinition{tenth, eleventh}}
+
+	err := definition.Validate()
+	var defErr *CourseDefinitionError
+	if !errors.As(err, &defErr) {
+		t.Fatalf("expected CourseDefinitionError, got %v", err)
+	}
+	if defErr.Number != 11 || defErr.Hole != 2 {
+		t.Errorf("error hole = %d at position %d, want 11 at position 2", defErr.Number, defErr.Hole)
+	}
+	if !strings.HasPrefix(err.Error(), "hole 11 (position 2 in the file): par") {
+		t.Errorf("error message %q should name the hole by its number", err.Error())
+	}
+}
+
+func TestCourseDefinition_ValidationChecksRatingAndStrokeIndex(t *testing.T) {
+	second := validHoleDefinition()
+	second.Number = 2
+	second.StrokeIndex = 1
+	first := validHoleDefinition()
+	first.StrokeIndex = 1
+
+	duplicate := CourseDefinition{Version: CurrentCourseVersion, Holes: []HoleDefinition{first, second}}
+	if err := duplicate.Validate(); err == nil || !strings.Contains(err.Error(), "hole 2: stroke_index") {
+		t.Errorf("expected duplicate stroke index error on hole 2, got %v", err)
+	}
+
+	second.StrokeIndex = 3
+	outOfRange := CourseDefinition{Version: CurrentCourseVersion, Holes: []HoleDefinition{first, second}}
+	if err := outOfRange.Validate(); err == nil || !strings.Contains(err.Error(), "hole 2: stroke_index") {
+		t.Errorf("expected stroke index range error on hole 2, got %v", err)
+	}
+
+	steep := CourseDefinition{Version: CurrentCourseVersion, Slope: 200, Holes: []HoleDefinition{first}}
+	if err := steep.Validate(); err == nil || !strings.Contains(err.Error(), "course: slope") {
+		t.Errorf("expected slope error, got %v", err)
+	}
+}
+
+func TestCourseDefinition_ValidationRejectsUnknownVersion(t *testing.T) {
+	definition := CourseDefinition{Version: 99, Holes: []HoleDefinition{validHoleDefinition()}}
+
+	err := definition.Validate()
+	if err == nil || !strings.Contains(err.Error(), "version") {
+		t.Errorf("expected version error, got %v", err)
+	}
+}
+
+func TestLoadCourse(t *testing.T) {
+	course, err := LoadCourse(filepath.Join("courses", "meadowbrook.json"))
+	if err != nil {
+		t.Fatalf("LoadCourse returned error: %v", err)
+	}
+
+	if len(course.Holes) != 3 {
+		t.Errorf("expected 3 holes, got %d", len(course.Holes))
+	}
+	if course.Rating != 12.4 || course.Slope != 121 {
+		t.Errorf("rating/slope = %.1f/%d, want 12.4/121", course.Rating, course.Slope)
+	}
+	if course.Holes[2].StrokeIndex != 1 {
+		t.Errorf("the par 5 should be stroke index 1, got %d", course.Holes[2].StrokeIndex)
+	}
+	for _, hole := range course.Holes {
+		if lie := hole.GetLieAtPosition(hole.TeeLocation); lie != Tee {
+			t.Errorf("hole %d tee lie = %v, want Tee", hole.Number, lie)
+		}
+		if lie := hole.GetLieAtPosition(hole.HoleLocation); lie != Green {
+			t.Errorf("hole %d pin lie = %v, want Green", hole.Number, lie)
+		}
+	}
+}
+
+func TestLoadCourse_RejectsUnknownFields(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "course.json")
+	os.WriteFile(path, []byte(`{"version": 1, "holes": [], "greens": 3}`), 0644)
+
+	if _, err := LoadCourse(path); err == nil {
+		t.Error("expected error for unknown field")
+	}
+}
+
+func TestLoadCourse_MissingFile(t *testing.T) {
+	if _, err := LoadCourse(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("expected error for missing file")
+	}
+}
@@ -0,0 +1,437 @@
+package gogolf
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sort"
+)
+
+// Yardage ranges for generated holes, keyed by par
+var generatedYardages = map[int][2]float64{
+	3: {140, 215},
+	4: {330, 450},
+	5: {480, 570},
+}
+
+const (
+	generatedHoleWidth   = 90.0
+	generatedCellSize    = 5.0
+	generatedTeeY        = 5.0
+	generatedBehindGreen = 25.0
+	fairwayHalfWidth     = 17.0
+	landingZoneHalfWidth = 11.0
+)
+
+// GenerateCourse builds a course from a seed. The same seed and hole count always produce the same course.
+// The hole count must be at least one; check counts that come from the player with GenerateCourseDefinition.
+func GenerateCourse(holeCount int, seed uint64) Course {
+	definition, err := GenerateCourseDefinition(holeCount, seed)
+	if err != nil {
+		panic(err)
+	}
+	course, err := definition.Build()
+	if err != nil {
+		// generated definitions are valid by construction
+		panic(fmt.Sprintf("generated invalid course: %v", err))
+	}
+	return course
+}
+
+// GenerateCourseDefinition lays out a seeded course with a realistic mix of par 3, 4 and 5 holes.
+// Holes include doglegs, fairways that pinch at the landing zone, rough bands, greenside bunkers
+// and penalty areas.
+func GenerateCourseDefinition(holeCount int, seed uint64) (CourseDefinition, error) {
+	if holeCount < 1 {
+		return CourseDefinition{}, fmt.Errorf("a course needs at least one hole, got %d", holeCount)
+	}
+	random := rand.New(rand.NewPCG(seed, seed))
+
+	pars := generatedPars(holeCount, random)
+	holes := make([]HoleDefinition, 0, holeCount)
+	for i, par := range pars {
+		holes = append(holes, generateHole(i+1, par, random))
+	}
+
+	definition := CourseDefinition{
+		Version: CurrentCourseVersion,
+		Name:    fmt.Sprintf("Generated #%d", seed),
+		Holes:   holes,
+	}
+	definition.rate()
+	return definition, nil
+}
+
+// generatedHazardRating is how many strokes each bunker or penalty area adds for a scratch golfer,
+// and generatedHazardSlope how many slope points each one over the usual number per eighteen holes
+// adds for a bogey golfer
+const (
+	generatedHazardRating       = 0.02
+	generatedHazardSlope        = 1.0
+	generatedHazardsPerEighteen = 45.0
+)
+
+// rate gives a generated course its rating, slope and stroke indexes. Long holes for their par
+// play harder, as does every bunker and penalty area; the hardest hole gets stroke index 1.
+func (d *CourseDefinition) rate() {
+	par := 0
+	rating := 0.0
+	hazards := 0
+	difficulty := make([]float64, len(d.Holes))
+	for i, hole := range d.Holes {
+		yardRange := generatedYardages[hole.Par]
+		yardage := math.Hypot(hole.Pin.X-hole.Tee.X, hole.Pin.Y-hole.Tee.Y)
+		length := (yardage - yardRange[0]) / (yardRange[1] - yardRange[0])
+		holeHazards := 0
+		for _, region := range hole.Regions {
+			if region.Lie == "bunker" || region.Lie == "penalty_area" {
+				holeHazards++
+			}
+		}
+		par += hole.Par
+		hazards += holeHazards
+		rating += float64(hole.Par) + length - 0.5 + float64(holeHazards)*generatedHazardRating
+		difficulty[i] = length + float64(holeHazards)*0.1
+	}
+
+	d.Rating = math.Round(rating*10) / 10
+	perEighteen := float64(hazards) * 18 / float64(len(d.Holes))
+	d.Slope = int(math.Round(StandardSlope + (perEighteen-generatedHazardsPerEighteen)*generatedHazardSlope))
+	d.Slope = max(MinimumSlope, min(d.Slope, MaximumSlope))
+
+	order := make([]int, len(d.Holes))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool { return difficulty[order[a]] > difficulty[order[b]] })
+	for rank, i := range order {
+		d.Holes[i].StrokeIndex = rank + 1
+	}
+}
+
+// generatedPars mixes pars in the proportion of a par 72 (four par 3s and four par 5s per 18)
+// and keeps the opening hole a par 4 whenever one is available
+func generatedPars(holeCount int, random RandomSource) []int {
+	shortAndLong := int(math.Round(float64(holeCount) * 2 / 9))
+	pars := make([]int, 0, holeCount)
+	for i := 0; i < holeCount; i++ {
+		switch {
+		case i < shortAndLong:
+			pars = append(pars, 3)
+		case i < shortAndLong*2:
+			pars = append(pars, 5)
+		default:
+			pars = append(pars, 4)
+		}
+	}
+
+	for i := len(pars) - 1; i > 0; i-- {
+		j := random.IntN(i + 1)
+		pars[i], pars[j] = pars[j], pars[i]
+	}
+
+	for i, par := range pars {
+		if par == 4 {
+			pars[0], pars[i] = pars[i], pars[0]
+			break
+		}
+	}
+	return pars
+}
+
+// holeLayout describes the centerline of a generated hole
+type holeLayout struct {
+	teeX    float64
+	cornerY float64
+	greenX  float64
+	greenY  float64
+	landing float64
+}
+
+// centerX returns the x position of the hole's centerline at distance y from the tee
+func (l holeLayout) centerX(y float64) float64 {
+	if y <= l.cornerY {
+		return l.teeX
+	}
+	t := math.Min((y-l.cornerY)/(l.greenY-l.cornerY), 1)
+	return l.teeX + (l.greenX-l.teeX)*t
+}
+
+// fairwayHalfWidthAt narrows the fairway to its tightest at the landing zone
+func (l holeLayout) fairwayHalfWidthAt(y float64) float64 {
+	pinch := math.Max(0, 1-math.Abs(y-l.landing)/40)
+	return fairwayHalfWidth - (fairwayHalfWidth-landingZoneHalfWidth)*pinch
+}
+
+func generateHole(number, par int, random RandomSource) HoleDefinition {
+	yardRange := generatedYardages[par]
+	yardage := math.Round(yardRange[0] + random.Float64()*(yardRange[1]-yardRange[0]))
+	length := yardage + generatedTeeY + generatedBehindGreen
+
+	layout := holeLayout{
+		teeX:    generatedHoleWidth / 2,
+		cornerY: yardage,
+		greenX:  generatedHoleWidth / 2,
+		greenY:  yardage + generatedTeeY,
+		landing: math.Min(250, yardage*0.65),
+	}
+
+	if par > 3 {
+		// doglegs bend at the landing zone, left or right
+		dogleg := []float64{-1, 0, 1}[random.IntN(3)]
+		bend := 15 + random.Float64()*12
+		layout.teeX = generatedHoleWidth/2 - dogleg*bend/2
+		layout.greenX = generatedHoleWidth/2 + dogleg*bend/2
+		layout.cornerY = layout.landing
+	}
+
+	greenCenter := PointDefinition{X: layout.greenX, Y: layout.greenY}
+	greenRadiusX := 11 + random.Float64()*4
+	greenRadiusY := 12 + random.Float64()*5
+	pin := PointDefinition{
+		X: greenCenter.X + (random.Float64()*2-1)*greenRadiusX*0.5,
+		Y: greenCenter.Y + (random.Float64()*2-1)*greenRadiusY*0.5,
+	}
+	tee := PointDefinition{X: layout.teeX, Y: generatedTeeY}
+
+	var regions []RegionDefinition
+	if par > 3 {
+		fairwayStart := 40.0
+		fairwayEnd := greenCenter.Y - greenRadiusY
+		regions = append(regions,
+			layout.band("rough", fairwayStart-20, greenCenter.Y+greenRadiusY, 16),
+			layout.band("first_cut", fairwayStart-5, fairwayEnd+5, 4),
+			layout.band("fairway", fairwayStart, fairwayEnd, 0),
+		)
+		regions = append(regions, layout.fairwayBunker(random))
+		if random.IntN(3) == 0 {
+			regions = append(regions, layout.lateralPenaltyArea(random))
+		}
+	} else {
+		regions = append(regions, RegionDefinition{
+			Lie:     "rough",
+			Shape:   RegionEllipse,
+			Center:  &PointDefinition{X: greenCenter.X, Y: greenCenter.Y},
+			RadiusX: greenRadiusX + 14,
+			RadiusY: greenRadiusY + 14,
+		})
+		if random.IntN(5) < 2 {
+			regions = append(regions, RegionDefinition{
+				Lie:     "penalty_area",
+				Shape:   RegionEllipse,
+				Center:  &PointDefinition{X: greenCenter.X, Y: greenCenter.Y - greenRadiusY - 22},
+				RadiusX: 30,
+				RadiusY: 12,
+			})
+		}
+	}
+
+	regions = append(regions, RegionDefinition{
+		Lie:     "first_cut",
+		Shape:   RegionEllipse,
+		Center:  &PointDefinition{X: greenCenter.X, Y: greenCenter.Y},
+		RadiusX: greenRadiusX + 4,
+		RadiusY: greenRadiusY + 4,
+	})
+	regions = append(regions, greensideBunkers(greenCenter, greenRadiusX, greenRadiusY, random)...)
+	regions = append(regions,
+		RegionDefinition{
+			Lie:     "green",
+			Shape:   RegionEllipse,
+			Center:  &PointDefinition{X: greenCenter.X, Y: greenCenter.Y},
+			RadiusX: greenRadiusX,
+			RadiusY: greenRadiusY,
+		},
+		RegionDefinition{
+			Lie:   "tee",
+			Shape: RegionRectangle,
+			Min:   &PointDefinition{X: tee.X - 6, Y: 0},
+			Max:   &PointDefinition{X: tee.X + 6, Y: generatedTeeY + 7},
+		},
+	)
+
+	var obstacles []ObstacleDefinition
+	if par > 3 {
+		obstacles = append(obstacles, layout.treeLines(random)...)
+		obstacles = append(obstacles, layout.landingZoneTrees(random)...)
+	}
+	obstacles = append(obstacles, boundaryStakes(length)...)
+
+	return HoleDefinition{
+		Number:     number,
+		Par:        par,
+		Tee:        tee,
+		Pin:        pin,
+		Grid:       GridDefinition{Width: generatedHoleWidth, Length: length, CellSize: generatedCellSize},
+		DefaultLie: "deep_rough",
+		Regions:    regions,
+		Obstacles:  obstacles,
+		Green:      generateGreen(greenCenter, random),
+		Elevation:  layout.elevation(par, random),
+	}
+}
+
+// generateGreen tilts the green up to 2.5% in any direction at a stimp between 8 and 12,
+// and crowns about a third of them
+func generateGreen(center PointDefinition, random RandomSource) *GreenDefinition {
+	direction := Vector{X: 0, Y: 1}.Rotate(random.Float64() * 360)
+	grade := 0.5 + random.Float64()*2
+	green := &GreenDefinition{
+		Stimp: float64(8 + random.IntN(5)),
+		Slope: SlopeDefinition{X: math.Round(direction.X*grade*10) / 10, Y: math.Round(direction.Y*grade*10) / 10},
+	}
+	if random.IntN(3) == 0 {
+		green.Crown = math.Round((0.5+random.Float64())*10) / 10
+		green.Center = &PointDefinition{X: center.X, Y: center.Y}
+	}
+	return green
+}
+
+// elevation raises or drops the tee up to 30 feet against the green and, on longer holes,
+// sometimes banks the landing zone so the drive finishes on a sidehill lie
+func (l holeLayout) elevation(par int, random RandomSource) *ElevationDefinition {
+	elevation := &ElevationDefinition{
+		Tee:   math.Round(-10 + random.Float64()*40),
+		Green: math.Round(-10 + random.Float64()*20),
+	}
+	if par > 3 && random.IntN(3) == 0 {
+		side := []float64{-1, 1}[random.IntN(2)]
+		radius := 15 + random.Float64()*10
+		elevation.Hills = append(elevation.Hills, HillDefinition{
+			Center:  PointDefinition{X: clampToHole(l.centerX(l.landing) + side*(fairwayHalfWidth+radius/2)), Y: l.landing},
+			RadiusX: radius,
+			RadiusY: radius * 1.5,
+			Height:  math.Round(4 + random.Float64()*8),
+		})
+	}
+	return elevation
+}
+
+// clampToHole keeps generated features inside the grid
+func clampToHole(x float64) float64 {
+	return math.Max(1, math.Min(generatedHoleWidth-1, x))
+}
+
+// treeLines lines one or both sides of the hole with trees from the tee shot up to the approach
+func (l holeLayout) treeLines(random RandomSource) []ObstacleDefinition {
+	var lines []ObstacleDefinition
+	startY := 60.0
+	endY := math.Min(l.landing+60, l.greenY-40)
+	for _, side := range []float64{-1, 1} {
+		if random.IntN(3) == 0 {
+			continue
+		}
+		offset := 20 + random.Float64()*4
+		lines = append(lines, ObstacleDefinition{
+			Kind:   "tree_line",
+			Start:  PointDefinition{X: clampToHole(l.centerX(startY) + side*(l.fairwayHalfWidthAt(startY)+offset)), Y: startY},
+			End:    &PointDefinition{X: clampToHole(l.centerX(endY) + side*(l.fairwayHalfWidthAt(endY)+offset)), Y: endY},
+			Radius: 3,
+			Height: 50 + random.Float64()*20,
+		})
+	}
+	return lines
+}
+
+// landingZoneTrees stand in the rough beside where tee shots come down
+func (l holeLayout) landingZoneTrees(random RandomSource) []ObstacleDefinition {
+	var trees []ObstacleDefinition
+	count := random.IntN(3)
+	for i := 0; i < count; i++ {
+		side := float64(1 - 2*random.IntN(2))
+		y := l.landing + (random.Float64()*2-1)*30
+		trees = append(trees, ObstacleDefinition{
+			Kind:   "tree",
+			Start:  PointDefinition{X: clampToHole(l.centerX(y) + side*(l.fairwayHalfWidthAt(y)+8+random.Float64()*6)), Y: y},
+			Radius: 3 + random.Float64()*2,
+			Height: 35 + random.Float64()*25,
+		})
+	}
+	return trees
+}
+
+// boundaryStakes mark the out of bounds line along both sides of the grid
+func boundaryStakes(length float64) []ObstacleDefinition {
+	var stakes []ObstacleDefinition
+	for y := 40.0; y < length-10; y += 40 {
+		for _, x := range []float64{0.5, generatedHoleWidth - 0.5} {
+			stakes = append(stakes, ObstacleDefinition{
+				Kind:   "ob_stake",
+				Start:  PointDefinition{X: x, Y: y},
+				Radius: 0.2,
+				Height: 3,
+			})
+		}
+	}
+	return stakes
+}
+
+// band traces a polygon that follows the centerline from startY to endY,
+// extending margin yards past the fairway edge on each side
+func (l holeLayout) band(lie string, startY, endY, margin float64) RegionDefinition {
+	var left, right []PointDefinition
+	for y := startY; ; y += 10 {
+		if y > endY {
+			y = endY
+		}
+		halfWidth := l.fairwayHalfWidthAt(y) + margin
+		left = append(left, PointDefinition{X: l.centerX(y) - halfWidth, Y: y})
+		right = append(right, PointDefinition{X: l.centerX(y) + halfWidth, Y: y})
+		if y == endY {
+			break
+		}
+	}
+
+	points := left
+	for i := len(right) - 1; i >= 0; i-- {
+		points = append(points, right[i])
+	}
+	return RegionDefinition{Lie: lie, Shape: RegionPolygon, Points: points}
+}
+
+// fairwayBunker guards one edge of the landing zone
+func (l holeLayout) fairwayBunker(random RandomSource) RegionDefinition {
+	side := float64(1 - 2*random.IntN(2))
+	y := l.landing + (random.Float64()*2-1)*15
+	return RegionDefinition{
+		Lie:     "bunker",
+		Shape:   RegionEllipse,
+		Center:  &PointDefinition{X: l.centerX(y) + side*(l.fairwayHalfWidthAt(y)+3), Y: y},
+		RadiusX: 5,
+		RadiusY: 9,
+	}
+}
+
+// lateralPenaltyArea runs alongside the fairway beyond the rough
+func (l holeLayout) lateralPenaltyArea(random RandomSource) RegionDefinition {
+	side := float64(1 - 2*random.IntN(2))
+	y := l.landing + random.Float64()*40
+	return RegionDefinition{
+		Lie:     "penalty_area",
+		Shape:   RegionEllipse,
+		Center:  &PointDefinition{X: l.centerX(y) + side*(l.fairwayHalfWidthAt(y)+14), Y: y},
+		RadiusX: 8,
+		RadiusY: 35,
+	}
+}
+
+// greensideBunkers places one to three bunkers hugging the edge of the green
+func greensideBunkers(center PointDefinition, radiusX, radiusY float64, random RandomSource) []RegionDefinition {
+	count := 1 + random.IntN(3)
+	var bunkers []RegionDefinition
+	for i := 0; i < count; i++ {
+		// keep bunkers off the back of the green so there is always a way in from the front
+		angle := math.Pi*0.9 + random.Float64()*math.Pi*1.2
+		bunkers = append(bunkers, RegionDefinition{
+			Lie:   "bunker",
+			Shape: RegionEllipse,
+			Center: &PointDefinition{
+				X: center.X + math.Cos(angle)*(radiusX+3),
+				Y: center.Y + math.Sin(angle)*(radiusY+3),
+			},
+			RadiusX: 4 + random.Float64()*2,
+			RadiusY: 4 + random.Float64()*2,
+		})
+	}
+	return bunkers
+}
@@ -0,0 +1,177 @@
+package gogolf
+
+import (
+	"reflect"
+	"testing"
+)
+
+func generateDefinition(t *testing.T, holeCount int, seed uint64) CourseDefinition {
+	t.Helper()
+	definition, err := GenerateCourseDefinition(holeCount, seed)
+	if err != nil {
+		t.Fatalf("GenerateCourseDefinition returned error: %v", err)
+	}
+	return definition
+}
+
+func TestGenerateCourse_SameSeedSameCourse(t *testing.T) {
+	first := generateDefinition(t, 18, 99)
+	second := generateDefinition(t, 18, 99)
+
+	if !reflect.DeepEqual(first, second) {
+		t.Error("expected the same seed to produce the same course definition")
+	}
+
+	firstCourse := GenerateCourse(9, 7)
+	secondCourse := GenerateCourse(9, 7)
+	if !reflect.DeepEqual(firstCourse, secondCourse) {
+		t.Error("expected the same seed to produce the same course")
+	}
+}
+
+func TestGenerateCourse_GreensHaveSpeedAndSlope(t *testing.T) {
+	for _, hole := range generateDefinition(t, 18, 5).Holes {
+		if hole.Green == nil {
+			t.Fatalf("hole %d has no green definition", hole.Number)
+		}
+		if hole.Green.Stimp < 8 || hole.Green.Stimp > 12 {
+			t.Errorf("hole %d stimp %v outside 8-12", hole.Number, hole.Green.Stimp)
+		}
+		if hole.Green.Slope == (SlopeDefinition{}) {
+			t.Errorf("hole %d green is dead flat", hole.Number)
+		}
+	}
+}
+
+func TestGenerateCourse_HolesHaveElevation(t *testing.T) {
+	course := GenerateCourse(18, 5)
+	changes := 0
+	for _, hole := range course.Holes {
+		if hole.ElevationChange(hole.TeeLocation, hole.HoleLocation) != 0 {
+			changes++
+		}
+	}
+	if changes == 0 {
+		t.Error("expected some generated holes to play uphill or downhill")
+	}
+}
+
+func TestGenerateCourse_DifferentSeedsDiffer(t *testing.T) {
+	if reflect.DeepEqual(generateDefinition(t, 9, 1), generateDefinition(t, 9, 2)) {
+		t.Error("expected different seeds to produce different courses")
+	}
+}
+
+func TestGenerateCourseDefinition_RejectsHoleCount(t *testing.T) {
+	for _, holes := range []int{0, -1} {
+		if _, err := GenerateCourseDefinition(holes, 1); err == nil {
+			t.Errorf("expected an error generating %d holes", holes)
+		}
+	}
+}
+
+func TestGenerateCourse_ParMix(t *testing.T) {
+	tests := []struct {
+		holes    int
+		wantPar  int
+		wantPar3 int
+		wantPar5 int
+	}{
+		{18, 72, 4, 4},
+		{9, 36, 2, 2},
+	}
+
+	for _, tt := range tests {
+		for seed := uint64(0); seed < 10; seed++ {
+			course := GenerateCourse(tt.holes, seed)
+			counts := map[int]int{}
+			for _, hole := range course.Holes {
+				counts[hole.Par]++
+			}
+
+			if course.Par() != tt.wantPar {
+				t.Errorf("%d holes seed %d: par = %d, want %d", tt.holes, seed, course.Par(), tt.wantPar)
+			}
+			if counts[3] != tt.wantPar3 || counts[5] != tt.wantPar5 {
+				t.Errorf("%d holes seed %d: got %d par 3s and %d par 5s, want %d and %d",
+					tt.holes, seed, counts[3], counts[5], tt.wantPar3, tt.wantPar5)
+			}
+			if course.Holes[0].Par != 4 {
+				t.Errorf("%d holes seed %d: opening hole par = %d, want 4", tt.holes, seed, course.Holes[0].Par)
+			}
+		}
+	}
+}
+
+func TestGenerateCourse_YardagesMatchPar(t *testing.T) {
+	course := GenerateCourse(18, 3)
+
+	for _, hole := range course.Holes {
+		yardRange := generatedYardages[hole.Par]
+		if float64(hole.Distance) < yardRange[0]-20 || float64(hole.Distance) > yardRange[1]+20 {
+			t.Errorf("hole %d par %d distance %.0f outside %v", hole.Number, hole.Par, hole.Distance, yardRange)
+		}
+	}
+}
+
+func TestGenerateCourse_LiesArePainted(t *testing.T) {
+	for seed := uint64(0); seed < 20; seed++ {
+		course := GenerateCourse(18, seed)
+
+		for _, hole := range course.Holes {
+			if lie := hole.GetLieAtPosition(hole.TeeLocation); lie != Tee {
+				t.Errorf("seed %d hole %d: tee lie = %v, want Tee", seed, hole.Number, lie)
+			}
+			if lie := hole.GetLieAtPosition(hole.HoleLocation); lie != Green {
+				t.Errorf("seed %d hole %d: pin lie = %v, want Green", seed, hole.Number, lie)
+			}
+		}
+	}
+}
+
+func TestGenerateCourse_IncludesHazards(t *testing.T) {
+	course := GenerateCourse(18, 42)
+	lies := map[LieType]int{}
+
+	for _, hole := range course.Holes {
+		for _, row := range hole.Grid.Cells {
+			for _, cell := range row {
+				lies[cell.Lie]++
+			}
+		}
+	}
+
+	for _, lie := range []LieType{Fairway, FirstCut, Rough, DeepRough, Bunker, Green, PenaltyArea} {
+		if lies[lie] == 0 {
+			t.Errorf("expected generated course to include %v", lie)
+		}
+	}
+}
+
+func TestGenerateCourse_FairwayNarrowsAtLandingZone(t *testing.T) {
+	layout := holeLayout{teeX: 45, cornerY: 250, greenX: 45, greenY: 400, landing: 250}
+
+	if layout.fairwayHalfWidthAt(layout.landing) >= layout.fairwayHalfWidthAt(100) {
+		t.Error("expected fairway to be narrower at the landing zone")
+	}
+}
+
+func TestGenerateCourse_IsRatedWithStrokeIndexes(t *testing.T) {
+	definition := generateDefinition(t, 18, 11)
+
+	if definition.Slope < MinimumSlope || definition.Slope > MaximumSlope {
+		t.Errorf("slope %d outside %d-%d", definition.Slope, MinimumSlope, MaximumSlope)
+	}
+	par := 0
+	seen := map[int]bool{}
+	for _, hole := range definition.Holes {
+		par += hole.Par
+		if hole.StrokeIndex < 1 || hole.StrokeIndex > 18 || seen[hole.StrokeIndex] {
+			t.Errorf("hole %d has stroke index %d, want each of 1-18 once", hole.Number, hole.StrokeIndex)
+		}
+		seen[hole.StrokeIndex] = true
+	}
+	if definition.Rating < float64(par)-4 || definition.Rating > float64(par)+6 {
+		t.Errorf("rating %.1f is far from par %d", definition.Rating, par)
+	}
+}
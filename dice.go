@@ -3,14 +3,24 @@ package gogolf
 import "math/rand/v2"
 
 type Dice struct {
-	Sides int
+	Sides  int
+	random RandomSource
 }
 
 func NewD6() Dice {
 	return Dice{Sides: 6}
 }
 
+// NewD6WithRandom creates a six-sided die that draws from the given source,
+// so that rolls can be replayed from a seed
+func NewD6WithRandom(random RandomSource) Dice {
+	return Dice{Sides: 6, random: random}
+}
+
 func (d Dice) Roll() int {
+	if d.random != nil {
+		return d.random.IntN(d.Sides) + 1
+	}
 	return rand.IntN(d.Sides) + 1
 }
 
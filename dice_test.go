@@ -1,6 +1,7 @@
 package gogolf
 
 import (
+	"math/rand/v2"
 	"testing"
 )
 
@@ -104,3 +105,21 @@ func TestSkillCheck_MarginCalculation(t *testing.T) {
 		}
 	}
 }
+
+func TestDice_RollWithRandomIsReproducible(t *testing.T) {
+	d1 := NewD6WithRandom(rand.New(rand.NewPCG(7, 7)))
+	d2 := NewD6WithRandom(rand.New(rand.NewPCG(7, 7)))
+
+	for i := 0; i < 20; i++ {
+		_, rolls1 := d1.RollN(3)
+		_, rolls2 := d2.RollN(3)
+		for j := range rolls1 {
+			if rolls1[j] != rolls2[j] {
+				t.Fatalf("roll %d: seeded dice diverged: %v vs %v", i, rolls1, rolls2)
+			}
+			if rolls1[j] < 1 || rolls1[j] > 6 {
+				t.Errorf("Individual roll = %d, want 1-6", rolls1[j])
+			}
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package gogolf
+
+import (
+	"fmt"
+	"math"
+)
+
+// Stance grades, in percent, at which a slope starts to trouble a full swing and where it becomes severe
+const (
+	awkwardStance = 4.0
+	severeStance  = 10.0
+)
+
+// ElevationAtPosition returns the height of the cell at a position, or zero off the grid
+func (g CourseGrid) ElevationAtPosition(pos Point) Foot {
+	row, col := g.positionToIndices(pos)
+	if !g.InBounds(pos) {
+		return 0
+	}
+	return g.Cells[row][col].Elevation
+}
+
+// SetElevationAtPosition sets the height of the cell at a position.
+// Does nothing if position is out of bounds
+func (g *CourseGrid) SetElevationAtPosition(pos Point, elevation Foot) {
+	row, col := g.positionToIndices(pos)
+	if !g.InBounds(pos) {
+		return
+	}
+	g.Cells[row][col].Elevation = elevation
+}
+
+// ElevationAt returns the height of the ground at a position; holes without a grid are flat
+func (h Hole) ElevationAt(pos Point) Foot {
+	if h.Grid == nil {
+		return 0
+	}
+	return h.Grid.ElevationAtPosition(pos)
+}
+
+// ElevationChange returns how far the ground at to is above the ground at from
+func (h Hole) ElevationChange(from, to Point) Foot {
+	return h.ElevationAt(to) - h.ElevationAt(from)
+}
+
+// PlaysLike returns the effective distance from one point to another, adding a yard
+// for every three feet the target sits above the ball and taking one off for every three below
+func (h Hole) PlaysLike(from, to Point) Yard {
+	return EffectiveDistance(from.Distance(to).Yards(), h.ElevationChange(from, to))
+}
+
+// EffectiveDistance is how far a shot of distance yards plays to a target rise feet above the ball
+func EffectiveDistance(distance Yard, rise Foot) Yard {
+	return max(distance+rise.Yards(), 0)
+}
+
+// GroundDistance is the inverse of EffectiveDistance: how far along the ground a shot that
+// would carry distance yards on the flat travels to land rise feet above the ball
+func GroundDistance(distance Yard, rise Foot) Yard {
+	return max(distance-rise.Yards(), distance/2)
+}
+
+// TerrainGradient returns the uphill direction of the ground at a position with its steepness
+// in percent grade, measured across the neighbouring cells
+func (h Hole) TerrainGradient(pos Point) Vector {
+	if h.Grid == nil {
+		return Vector{}
+	}
+	step := h.Grid.CellSize.Units()
+	run := float64(2 * step.Feet())
+	rise := func(dx, dy Unit) float64 {
+		ahead := Point{X: pos.X + int(dx), Y: pos.Y + int(dy)}
+		behind := Point{X: pos.X - int(dx), Y: pos.Y - int(dy)}
+		if !h.Grid.InBounds(ahead) || !h.Grid.InBounds(behind) {
+			return 0
+		}
+		return float64(h.ElevationChange(behind, ahead))
+	}
+	return Vector{X: rise(step, 0) / run * 100, Y: rise(0, step) / run * 100}
+}
+
+// Stance is how the ground tilts under the golfer's feet for a shot along a line, in percent grade
+type Stance struct {
+	// Uphill is positive when the ground rises toward the target and negative on a downhill lie
+	Uphill float64
+	// BallAboveFeet is positive when the ground rises from the golfer to the ball and negative when the ball is below their feet
+	BallAboveFeet float64
+}
+
+// StanceAt returns the stance a right-handed golfer takes at pos to play along direction.
+// They stand to the left of the line, so ground rising to the right puts the ball above their feet.
+func (h Hole) StanceAt(pos Point, direction Vector) Stance {
+	if direction.Magnitude() == 0 {
+		return Stance{}
+	}
+	gradient := h.TerrainGradient(pos)
+	forward := direction.Normalize()
+	return Stance{
+		Uphill:        gradient.Dot(forward),
+		BallAboveFeet: gradient.Dot(forward.Rotate(90)),
+	}
+}
+
+// IsLevel reports whether the slope is too gentle to affect the swing
+func (s Stance) IsLevel() bool {
+	return math.Abs(s.Uphill) < awkwardStance && math.Abs(s.BallAboveFeet) < awkwardStance
+}
+
+// DifficultyModifier is the penalty to a target number for swinging from this stance.
+// Each tilt counts on its own; downhill lies and balls below the feet are the harder of each pair.
+func (s Stance) DifficultyModifier() int {
+	return stancePenalty(s.Uphill, 1, 2) + stancePenalty(-s.Uphill, 1, 3) +
+		stancePenalty(s.BallAboveFeet, 1, 2) + stancePenalty(-s.BallAboveFeet, 1, 3)
+}
+
+// stancePenalty charges awkward once grade reaches awkwardStance and severe once it reaches severeStance
+func stancePenalty(grade float64, awkward, severe int) int {
+	switch {
+	case grade >= severeStance:
+		return -severe
+	case grade >= awkwardStance:
+		return -awkward
+	default:
+		return 0
+	}
+}
+
+func (s Stance) String() string {
+	if s.IsLevel() {
+		return "Level"
+	}
+	var parts []string
+	switch {
+	case s.Uphill >= awkwardStance:
+		parts = append(parts, fmt.Sprintf("Uphill %.0f%%", s.Uphill))
+	case s.Uphill <= -awkwardStance:
+		parts = append(parts, fmt.Sprintf("Downhill %.0f%%", -s.Uphill))
+	}
+	switch {
+	case s.BallAboveFeet >= awkwardStance:
+		parts = append(parts, fmt.Sprintf("ball above feet %.0f%%", s.BallAboveFeet))
+	case s.BallAboveFeet <= -awkwardStance:
+		parts = append(parts, fmt.Sprintf("ball below feet %.0f%%", -s.BallAboveFeet))
+	}
+	if len(parts) == 2 {
+		return parts[0] + ", " + parts[1]
+	}
+	return parts[0]
+}
@@ -0,0 +1,111 @@
+package gogolf
+
+import "testing"
+
+// rampHole is 40 yards wide and 100 long, rising one foot per yard toward the hole
+// with the ground also rising half a foot per yard to the right
+func rampHole() Hole {
+	hole := NewHoleWithGrid(1, 4, yards(20, 95), Size{}, 40, 100, 5)
+	for i := range hole.Grid.Cells {
+		for j := range hole.Grid.Cells[i] {
+			cell := &hole.Grid.Cells[i][j]
+			cell.Elevation = Foot(float64(Unit(cell.Position.Y).Yards()) + float64(Unit(cell.Position.X).Yards())/2)
+		}
+	}
+	return *hole
+}
+
+func TestEffectiveDistance(t *testing.T) {
+	tests := []struct {
+		distance Yard
+		rise     Foot
+		expected Yard
+	}{
+		{150, 0, 150},
+		{150, 30, 160},
+		{150, -30, 140},
+		{5, -30, 0},
+	}
+
+	for _, tt := range tests {
+		if got := EffectiveDistance(tt.distance, tt.rise); got != tt.expected {
+			t.Errorf("EffectiveDistance(%v, %v) = %v, want %v", tt.distance, tt.rise, got, tt.expected)
+		}
+	}
+}
+
+func TestGroundDistance(t *testing.T) {
+	if got := GroundDistance(160, 30); got != 150 {
+		t.Errorf("GroundDistance uphill = %v, want 150", got)
+	}
+	if got := GroundDistance(140, -30); got != 150 {
+		t.Errorf("GroundDistance downhill = %v, want 150", got)
+	}
+	if got := GroundDistance(20, 300); got != 10 {
+		t.Errorf("GroundDistance should never fall below half the carry, got %v", got)
+	}
+}
+
+func TestHole_PlaysLike(t *testing.T) {
+	hole := rampHole()
+	from := yards(20, 10)
+
+	if got := hole.PlaysLike(from, hole.HoleLocation); got <= from.Distance(hole.HoleLocation).Yards() {
+		t.Errorf("an uphill hole should play longer than its %v yards, got %v", from.Distance(hole.HoleLocation).Yards(), got)
+	}
+	if got := hole.PlaysLike(hole.HoleLocation, from); got >= from.Distance(hole.HoleLocation).Yards() {
+		t.Errorf("a downhill shot should play shorter, got %v", got)
+	}
+	if got := (Hole{HoleLocation: yards(0, 100)}).PlaysLike(yards(0, 0), yards(0, 100)); got != 100 {
+		t.Errorf("a hole without a grid is flat, got %v", got)
+	}
+}
+
+func TestHole_StanceAt(t *testing.T) {
+	hole := rampHole()
+	pos := yards(20, 50)
+
+	uphill := hole.StanceAt(pos, Vector{X: 0, Y: 1})
+	if uphill.Uphill < 32 || uphill.Uphill > 35 {
+		t.Errorf("playing up a 1-in-3 slope should read about 33%% uphill, got %+v", uphill)
+	}
+	if uphill.BallAboveFeet < 15 || uphill.BallAboveFeet > 18 {
+		t.Errorf("ground rising to the right should put the ball above the feet, got %+v", uphill)
+	}
+
+	downhill := hole.StanceAt(pos, Vector{X: 0, Y: -1})
+	if downhill.Uphill >= 0 || downhill.BallAboveFeet >= 0 {
+		t.Errorf("turning around should give a downhill lie with the ball below the feet, got %+v", downhill)
+	}
+
+	if flat := (Hole{}).StanceAt(pos, Vector{X: 0, Y: 1}); !flat.IsLevel() {
+		t.Errorf("a hole without a grid should give a level stance, got %+v", flat)
+	}
+}
+
+func TestStance_DifficultyModifier(t *testing.T) {
+	tests := []struct {
+		stance   Stance
+		expected int
+		text     string
+	}{
+		{Stance{}, 0, "Level"},
+		{Stance{Uphill: 3, BallAboveFeet: -3}, 0, "Level"},
+		{Stance{Uphill: 5}, -1, "Uphill 5%"},
+		{Stance{Uphill: 12}, -2, "Uphill 12%"},
+		{Stance{Uphill: -5}, -1, "Downhill 5%"},
+		{Stance{Uphill: -12}, -3, "Downhill 12%"},
+		{Stance{BallAboveFeet: 11}, -2, "ball above feet 11%"},
+		{Stance{BallAboveFeet: -11}, -3, "ball below feet 11%"},
+		{Stance{Uphill: -6, BallAboveFeet: 6}, -2, "Downhill 6%, ball above feet 6%"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.stance.DifficultyModifier(); got != tt.expected {
+			t.Errorf("%+v.DifficultyModifier() = %d, want %d", tt.stance, got, tt.expected)
+		}
+		if got := tt.stance.String(); got != tt.text {
+			t.Errorf("%+v.String() = %q, want %q", tt.stance, got, tt.text)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package gogolf
+
+import "math"
+
+// Flight is the path of a single shot, split into the carry through the air
+// and the roll along the ground after the ball lands
+type Flight struct {
+	Origin  Point
+	Landing Point
+	Rest    Point
+	Apex    Foot
+	// RollPath traces a roll that curved on a sloping green; nil when the ball rolled straight
+	RollPath []Point
+	// Spin is what the ball's spin did when it landed
+	Spin SpinOutcome
+}
+
+// Carry returns the vector from where the ball was struck to where it landed
+func (f Flight) Carry() Vector {
+	return f.Origin.Direction(f.Landing)
+}
+
+// Roll returns the vector from where the ball landed to where it came to rest
+func (f Flight) Roll() Vector {
+	return f.Landing.Direction(f.Rest)
+}
+
+// Path returns the vector from where the ball was struck to where it came to rest
+func (f Flight) Path() Vector {
+	return f.Origin.Direction(f.Rest)
+}
+
+func (f Flight) CarryDistance() Yard {
+	return Unit(f.Carry().Magnitude()).Yards()
+}
+
+func (f Flight) RollDistance() Yard {
+	return Unit(f.Roll().Magnitude()).Yards()
+}
+
+func (f Flight) TotalDistance() Yard {
+	return Unit(f.Path().Magnitude()).Yards()
+}
+
+// CarryFraction is the share of a full shot's distance covered in the air.
+// Putts never leave the ground, and higher lofts fly a larger share of the total.
+func (c Club) CarryFraction() float64 {
+	if c.Name == "Putter" {
+		return 0
+	}
+	return math.Min(0.86+float64(c.Loft)*0.002, 0.97)
+}
+
+// ApexHeight estimates how high a shot that carries the given distance climbs.
+// Lofted clubs launch higher relative to how far they fly.
+func (c Club) ApexHeight(carry Yard) Foot {
+	return Foot(float64(carry) * (0.3 + float64(c.Loft)*0.004))
+}
+
+// RollFactor scales the nominal roll-out of a shot once it lands on lie, before any spin.
+// Firm ground releases the ball, long grass grabs it and bunkers plug it.
+// RollFactorWithSpin adds what the ball's spin does on landing.
+func RollFactor(lie LieType) float64 {
+	switch lie {
+	case Tee, Fairway:
+		return 1.0
+	case FirstCut:
+		return 0.6
+	case Rough:
+		return 0.3
+	case DeepRough:
+		return 0.1
+	case Green:
+		return 0.7
+	default:
+		return 0
+	}
+}
+
+// RollOut moves the ball along the ground from where it landed, leaving PrevLocation
+// at the spot the shot was played from, and returns the roll vector
+func (ball *GolfBall) RollOut(direction Vector, distance Unit) Vector {
+	if distance <= 0 || direction.Magnitude() == 0 {
+		return Vector{}
+	}
+	landing := ball.Location
+	ball.Location = ball.Location.Move(direction, float64(distance))
+	return landing.Direction(ball.Location)
+}
@@ -0,0 +1,117 @@
+package gogolf
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFlight_Distances(t *testing.T) {
+	flight := Flight{
+		Origin:  Point{X: 0, Y: 0},
+		Landing: Point{X: 0, Y: int(Yard(200).Units())},
+		Rest:    Point{X: 0, Y: int(Yard(220).Units())},
+	}
+
+	if math.Abs(float64(flight.CarryDistance())-200) > 0.5 {
+		t.Errorf("CarryDistance = %.1f, want 200", flight.CarryDistance())
+	}
+	if math.Abs(float64(flight.RollDistance())-20) > 0.5 {
+		t.Errorf("RollDistance = %.1f, want 20", flight.RollDistance())
+	}
+	if math.Abs(float64(flight.TotalDistance())-220) > 0.5 {
+		t.Errorf("TotalDistance = %.1f, want 220", flight.TotalDistance())
+	}
+}
+
+func TestClub_CarryFraction(t *testing.T) {
+	clubs := DefaultClubs()
+	driver, lobWedge, putter := clubs[0], clubs[12], clubs[13]
+
+	if putter.CarryFraction() != 0 {
+		t.Errorf("Putter CarryFraction = %.2f, want 0", putter.CarryFraction())
+	}
+	if driver.CarryFraction() >= lobWedge.CarryFraction() {
+		t.Errorf("Driver should carry a smaller share than LW: %.2f vs %.2f",
+			driver.CarryFraction(), lobWedge.CarryFraction())
+	}
+	if lobWedge.CarryFraction() > 0.97 {
+		t.Errorf("LW CarryFraction = %.2f, should leave some roll", lobWedge.CarryFraction())
+	}
+}
+
+func TestRollFactor_ByLie(t *testing.T) {
+	fairway := RollFactor(Fairway)
+	rough := RollFactor(Rough)
+	deepRough := RollFactor(DeepRough)
+	bunker := RollFactor(Bunker)
+
+	if !(fairway > rough && rough > deepRough && deepRough > bunker) {
+		t.Errorf("expected roll to shrink from fairway (%.2f) to rough (%.2f) to deep rough (%.2f) to bunker (%.2f)",
+			fairway, rough, deepRough, bunker)
+	}
+	if bunker != 0 {
+		t.Errorf("ball should plug in a bunker, got roll factor %.2f", bunker)
+	}
+}
+
+func TestRollFactorWithSpin_SpinControlChecksUpOnGreen(t *testing.T) {
+	wedge := DefaultClubs()[11]
+	driver := DefaultClubs()[0]
+	roll := func(club Club, spinControl float32) float64 {
+		factor, _ := RollFactorWithSpin(Green, club, spinControl, Check)
+		return factor
+	}
+
+	budget := roll(wedge, 0.3)
+	proV1 := roll(wedge, 0.9)
+	if proV1 >= budget {
+		t.Errorf("higher spin control should roll less on the green: %.2f vs %.2f", proV1, budget)
+	}
+
+	wedgeSpinEffect := roll(wedge, 0) - roll(wedge, 0.9)
+	driverSpinEffect := roll(driver, 0) - roll(driver, 0.9)
+	if wedgeSpinEffect <= driverSpinEffect {
+		t.Errorf("spin should matter more with a lofted club: wedge %.2f vs driver %.2f",
+			wedgeSpinEffect, driverSpinEffect)
+	}
+}
+
+func TestGolfBall_RollOutKeepsPrevLocation(t *testing.T) {
+	ball := GolfBall{PrevLocation: Point{X: 0, Y: 0}, Location: Point{X: 0, Y: 100}}
+
+	roll := ball.RollOut(Vector{X: 0, Y: 1}, 50)
+
+	if ball.Location != (Point{X: 0, Y: 150}) {
+		t.Errorf("expected ball at {0 150} after roll, got %+v", ball.Location)
+	}
+	if ball.PrevLocation != (Point{X: 0, Y: 0}) {
+		t.Errorf("roll should not change PrevLocation, got %+v", ball.PrevLocation)
+	}
+	if roll.Y != 50 {
+		t.Errorf("roll vector = %+v, want {0 50}", roll)
+	}
+}
+
+func TestHole_DetectHoleOutOnFlight(t *testing.T) {
+	hole := Hole{HoleLocation: Point{X: 0, Y: 500}}
+
+	tests := []struct {
+		name     string
+		flight   Flight
+		expected bool
+	}{
+		{"rolls over the cup and stops past it", Flight{Origin: Point{0, 0}, Landing: Point{0, 450}, Rest: Point{0, 600}}, false},
+		{"rolls into the cup", Flight{Origin: Point{0, 0}, Landing: Point{0, 450}, Rest: Point{0, 501}}, true},
+		{"carries over the cup", Flight{Origin: Point{0, 0}, Landing: Point{0, 520}, Rest: Point{0, 530}}, false},
+		{"dunks on the fly", Flight{Origin: Point{0, 0}, Landing: Point{0, 500}, Rest: Point{0, 500}}, true},
+		{"plugs short", Flight{Origin: Point{0, 0}, Landing: Point{0, 400}, Rest: Point{0, 400}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hole.DetectHoleOutOnFlight(tt.flight); got != tt.expected {
+				t.Errorf("DetectHoleOutOnFlight = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
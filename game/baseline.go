@@ -0,0 +1,141 @@
+package game
+
+import (
+	"fmt"
+	"gogolf"
+	"sync"
+)
+
+// baselineDistances are the distances, in yards, the expected-strokes table is simulated from for each lie
+var baselineDistances = map[gogolf.LieType][]gogolf.Yard{
+	gogolf.Tee:       {100, 150, 200, 250, 300, 350, 400, 450, 500, 550},
+	gogolf.Fairway:   {10, 20, 30, 50, 75, 100, 150, 200, 250, 300, 400, 500},
+	gogolf.FirstCut:  {10, 20, 30, 50, 75, 100, 150, 200, 250, 300, 400, 500},
+	gogolf.Rough:     {10, 20, 30, 50, 75, 100, 150, 200, 250, 300, 400, 500},
+	gogolf.DeepRough: {10, 20, 30, 50, 75, 100, 150, 200, 250, 300, 400, 500},
+	gogolf.Bunker:    {10, 20, 30, 50, 75, 100, 150, 200, 250, 300, 400, 500},
+	gogolf.Green:     {1, 2, 3, 5, 7, 10, 15, 20},
+}
+
+// The baseline hole is a long, straight, flat hole with no hazards and room to miss, so the table
+// measures distance and lie and nothing else. Putts are simulated on a full size green; every
+// other lie on a small one, so a spot a few yards from the hole is still off the green.
+const (
+	baselineHoleLength = 700.0
+	baselineHoleWidth  = 200.0
+	baselinePinFromEnd = 80.0
+	baselineGreenSize  = 22.0
+	baselineTargetSize = 6.0
+)
+
+// baselineHole builds the hole a baseline shot from distance is simulated on, with a green of the
+// given radius. The fairway starts level with the ball, as it does from a tee, so the furthest
+// fairway in reach is always ahead of it.
+func baselineHole(distance gogolf.Yard, green float64) gogolf.HoleDefinition {
+	center := baselineHoleWidth / 2
+	pin := gogolf.PointDefinition{X: center, Y: baselineHoleLength - baselinePinFromEnd}
+	start := max(pin.Y-float64(distance), 0)
+	return gogolf.HoleDefinition{
+		Number:     1,
+		Par:        5,
+		Tee:        gogolf.PointDefinition{X: center, Y: 5},
+		Pin:        pin,
+		Grid:       gogolf.GridDefinition{Width: baselineHoleWidth, Length: baselineHoleLength, CellSize: 5},
+		DefaultLie: "rough",
+		Regions: []gogolf.RegionDefinition{
+			{Lie: "first_cut", Shape: gogolf.RegionRectangle, Min: &gogolf.PointDefinition{X: center - 20, Y: start}, Max: &gogolf.PointDefinition{X: center + 20, Y: pin.Y}},
+			{Lie: "fairway", Shape: gogolf.RegionRectangle, Min: &gogolf.PointDefinition{X: center - 15, Y: start}, Max: &gogolf.PointDefinition{X: center + 15, Y: pin.Y}},
+			{Lie: "green", Shape: gogolf.RegionEllipse, Center: &pin, RadiusX: green, RadiusY: green},
+		},
+	}
+}
+
+// BuildBaseline simulates a computer golfer of the given difficulty holing out from every lie and
+// distance in the table, trials times each, through the same shot pipeline as a real round.
+// The same seed always builds the same table.
+func BuildBaseline(difficulty Difficulty, trials int, seed uint64) gogolf.Baseline {
+	golfer := NewComputerGolfer("Baseline", difficulty)
+
+	baseline := gogolf.Baseline{}
+	stream := seed
+	for _, lie := range []gogolf.LieType{gogolf.Tee, gogolf.Fairway, gogolf.FirstCut, gogolf.Rough, gogolf.DeepRough, gogolf.Bunker, gogolf.Green} {
+		green := baselineTargetSize
+		if lie == gogolf.Green {
+			green = baselineGreenSize
+		}
+		for _, distance := range baselineDistances[lie] {
+			definition := gogolf.CourseDefinition{
+				Version: gogolf.CurrentCourseVersion,
+				Name:    "Baseline",
+				Holes:   []gogolf.HoleDefinition{baselineHole(distance, green)},
+			}
+			course, err := definition.Build()
+			if err != nil {
+				panic(err)
+			}
+			hole := course.Holes[0]
+			spot := hole.HoleLocation.Move(gogolf.Vector{X: 0, Y: -1}, float64(distance.Units()))
+			if lie != gogolf.Green {
+				hole.Grid.SetLieAtPosition(spot, lie)
+			}
+
+			g := newBaselineGame(golfer, difficulty, course, stream)
+			stream++
+
+			total := 0
+			for range trials {
+				total += g.holeOutFrom(spot)
+			}
+			baseline[lie] = append(baseline[lie], gogolf.BaselinePoint{Distance: distance, Strokes: float64(total) / float64(trials)})
+		}
+	}
+	return baseline
+}
+
+// newBaselineGame sets up a simulated game in calm weather with the computer playing every shot
+func newBaselineGame(golfer gogolf.Golfer, difficulty Difficulty, course gogolf.Course, stream uint64) *Game {
+	g := NewWithCourse(golfer, course, NewSeededRandom(stream))
+	g.Weather = gogolf.CalmWeather()
+	g.Computer = &ComputerPlayer{Difficulty: difficulty}
+	g.simulated = true
+	return g
+}
+
+// holeOutFrom plays the computer's shots from spot until the hole is finished and returns the strokes taken
+func (g *Game) holeOutFrom(spot gogolf.Point) int {
+	g.ScoreCard = gogolf.NewScoreCard(g.Course)
+	g.TeeUp()
+	g.Ball.Location = spot
+	g.Ball.PrevLocation = spot
+	for !g.IsHoleComplete() {
+		if _, err := g.PlayComputerShot(); err != nil {
+			// the baseline course always leaves the computer a shot or relief to take
+			panic(fmt.Sprintf("baseline hole could not be finished: %v", err))
+		}
+	}
+	return g.StrokesThisHole()
+}
+
+// Baseline table settings for DefaultBaseline
+const (
+	baselineTrials = 100
+	baselineSeed   = 2024
+)
+
+var (
+	defaultBaseline     gogolf.Baseline
+	defaultBaselineOnce sync.Once
+)
+
+// DefaultBaseline is the tour pro table strokes gained is measured against, simulated the first time it is needed
+func DefaultBaseline() gogolf.Baseline {
+	defaultBaselineOnce.Do(func() {
+		defaultBaseline = BuildBaseline(TourPro, baselineTrials, baselineSeed)
+	})
+	return defaultBaseline
+}
+
+// StrokesGained breaks down what the golfer's shots so far gained or lost against the default baseline
+func (g *Game) StrokesGained() gogolf.StrokesGained {
+	return DefaultBaseline().Analyze(g.ScoreCard)
+}
@@ -0,0 +1,88 @@
+package game
+
+import (
+	"gogolf"
+	"reflect"
+	"testing"
+)
+
+func TestBuildBaselineCoversEveryLie(t *testing.T) {
+	baseline := BuildBaseline(TourPro, 5, 1)
+
+	for lie, distances := range baselineDistances {
+		row := baseline[lie]
+		if len(row) != len(distances) {
+			t.Fatalf("%s: expected %d distances, got %d", lie, len(distances), len(row))
+		}
+		for i, point := range row {
+			if point.Distance != distances[i] || point.Strokes < 1 {
+				t.Errorf("%s: expected at least a stroke from %.0f, got %+v", lie, distances[i], point)
+			}
+		}
+	}
+	if short, long := baseline.ExpectedStrokes(gogolf.Fairway, 50), baseline.ExpectedStrokes(gogolf.Fairway, 500); short >= long {
+		t.Errorf("500 yards should take more strokes than 50: %.2f vs %.2f", long, short)
+	}
+	if baseline.ExpectedStrokes(gogolf.Green, 1) != 1 {
+		t.Errorf("a tour pro should hole every one yard putt, got %.2f", baseline.ExpectedStrokes(gogolf.Green, 1))
+	}
+}
+
+func TestBuildBaselineIsDeterministic(t *testing.T) {
+	if !reflect.DeepEqual(BuildBaseline(ClubGolfer, 2, 9), BuildBaseline(ClubGolfer, 2, 9)) {
+		t.Error("the same seed should build the same baseline")
+	}
+}
+
+func TestBaselineGolferKeepsItsLevel(t *testing.T) {
+	definition := gogolf.CourseDefinition{
+		Version: gogolf.CurrentCourseVersion,
+		Name:    "Baseline",
+		Holes:   []gogolf.HoleDefinition{baselineHole(150, baselineTargetSize)},
+	}
+	course, err := definition.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	golfer := NewComputerGolfer("Baseline", TourPro)
+	g := newBaselineGame(golfer, TourPro, course, 1)
+	spot := course.Holes[0].HoleLocation.Move(gogolf.Vector{X: 0, Y: -1}, float64(gogolf.Yard(150).Units()))
+
+	for range 300 {
+		g.holeOutFrom(spot)
+	}
+
+	want := NewComputerGolfer("Baseline", TourPro)
+	if !reflect.DeepEqual(golfer.Skills, want.Skills) {
+		t.Errorf("skills changed during simulation: %+v", golfer.Skills)
+	}
+	if !reflect.DeepEqual(golfer.Abilities, want.Abilities) {
+		t.Errorf("abilities changed during simulation: %+v", golfer.Abilities)
+	}
+}
+
+func TestBeginnerBaselineTakesMoreStrokes(t *testing.T) {
+	pro := BuildBaseline(TourPro, 10, 3)
+	beginner := BuildBaseline(Beginner, 10, 3)
+	if beginner.ExpectedStrokes(gogolf.Tee, 400) <= pro.ExpectedStrokes(gogolf.Tee, 400) {
+		t.Errorf("a beginner should expect more strokes from the tee than a tour pro: %.2f vs %.2f",
+			beginner.ExpectedStrokes(gogolf.Tee, 400), pro.ExpectedStrokes(gogolf.Tee, 400))
+	}
+}
+
+func TestStrokesGainedForARound(t *testing.T) {
+	g := newComputerGame(TourPro, 1, 5)
+	g.TeeUp()
+	for !g.IsHoleComplete() {
+		g.PlayComputerShot()
+	}
+
+	sg := g.StrokesGained()
+
+	hole := g.GetCurrentHole()
+	expected := DefaultBaseline().ExpectedStrokes(gogolf.Tee, g.ScoreCard.ShotsThisHole(hole)[0].FromHole)
+	want := expected - float64(g.StrokesThisHole())
+	if diff := sg.Total() - want; diff > 1e-6 || diff < -1e-6 {
+		t.Errorf("strokes gained should total the expected strokes less those taken: %.2f vs %.2f", sg.Total(), want)
+	}
+}
@@ -0,0 +1,238 @@
+package game
+
+import (
+	"fmt"
+	"gogolf"
+	"math"
+)
+
+// Difficulty is how strong a computer-controlled golfer is
+type Difficulty int
+
+const (
+	Beginner Difficulty = iota
+	ClubGolfer
+	TourPro
+)
+
+func (d Difficulty) String() string {
+	return [...]string{
+		"Beginner",
+		"Club Golfer",
+		"Tour Pro",
+	}[d]
+}
+
+// Difficulties lists every tier, weakest first
+func Difficulties() []Difficulty {
+	return []Difficulty{Beginner, ClubGolfer, TourPro}
+}
+
+// tier is what sets one difficulty apart from another: the golfer's levels and the quality of their decisions
+type tier struct {
+	// level is every skill and ability the golfer starts with
+	level int
+	// aggression is the chance of attacking the flag rather than playing to the safe target, and of shaping the ball
+	aggression float64
+	// hazardSense is the chance of noticing that a target brings a penalty area, bunker or out of bounds
+	// into play, of reading the break on the green and of choosing the best relief
+	hazardSense float64
+	// powerError is the largest share of the intended power a swing can stray by
+	powerError float64
+}
+
+var tiers = [...]tier{
+	Beginner:   {level: 1, aggression: 0.1, hazardSense: 0.3, powerError: 0.2},
+	ClubGolfer: {level: 4, aggression: 0.25, hazardSense: 0.7, powerError: 0.1},
+	TourPro:    {level: 8, aggression: 0.4, hazardSense: 0.95, powerError: 0.04},
+}
+
+// troubleRadius is how far either side of a target a golfer with hazard sense checks for trouble
+const troubleRadius = gogolf.Yard(10)
+
+// NewComputerGolfer creates a golfer whose skills and abilities are set by difficulty
+func NewComputerGolfer(name string, difficulty Difficulty) gogolf.Golfer {
+	golfer := gogolf.NewGolfer(name)
+	level := tiers[difficulty].level
+	for key, skill := range golfer.Skills {
+		skill.Level = level
+		golfer.Skills[key] = skill
+	}
+	for key, ability := range golfer.Abilities {
+		ability.Level = level
+		golfer.Abilities[key] = ability
+	}
+	return golfer
+}
+
+// ComputerPlayer makes a golfer's decisions when no one is at the keyboard. It sees only what a
+// human player is shown: the lie, the aim points, the club distances and the read of the green.
+type ComputerPlayer struct {
+	Difficulty Difficulty
+}
+
+// ShotPlan is everything decided before a shot is struck
+type ShotPlan struct {
+	Club     string
+	ShotType gogolf.ShotType
+	Shape    gogolf.ShotShape
+	Aim      gogolf.Aim
+	// Power is the swing the golfer actually makes, their intended power plus whatever they miss it by
+	Power float64
+}
+
+// PlanShot chooses the club, shot type, aim, shape and power for g's next shot and sets the
+// club and shot type up on g. Every random draw comes from the game's random source.
+func (c ComputerPlayer) PlanShot(g *Game) ShotPlan {
+	t := tiers[c.Difficulty]
+	hole := g.GetCurrentHole()
+	lie := g.Ball.GetLie(&hole)
+
+	club := g.SuggestClub(g.Caddie)
+	g.SelectClub(club.Name)
+	shotType := c.chooseShotType(g, lie)
+	g.SelectShotType(shotType)
+
+	plan := ShotPlan{Club: club.Name, ShotType: shotType, Shape: gogolf.Straight, Aim: c.chooseAim(g, lie)}
+	if club.Name != "Putter" && (shotType == gogolf.FullSwing || shotType == gogolf.Punch) && g.random.Float64() < t.aggression/2 {
+		plan.Shape = gogolf.Draw
+		if g.random.IntN(2) == 0 {
+			plan.Shape = gogolf.Fade
+		}
+	}
+
+	modified := shotType.Apply(g.Golfer.GetModifiedClub(club))
+	distance := hole.PlaysLike(g.Ball.Location, plan.Aim.Target)
+	intended := math.Min(float64(distance/plan.Aim.PlannedDistance(modified)), 1)
+	miss := (g.random.Float64()*2 - 1) * t.powerError
+	plan.Power = math.Max(math.Min(intended*(1+miss), 1), 0.05)
+	return plan
+}
+
+// chooseShotType splashes out of bunkers and chips from close to the green, otherwise makes a full swing
+func (c ComputerPlayer) chooseShotType(g *Game, lie gogolf.LieType) gogolf.ShotType {
+	types := g.ShotTypes()
+	has := func(want gogolf.ShotType) bool {
+		for _, shotType := range types {
+			if shotType == want {
+				return true
+			}
+		}
+		return false
+	}
+	hole := g.GetCurrentHole()
+	near := g.Ball.Location.Distance(hole.HoleLocation).Yards() <= 40
+	switch {
+	case lie == gogolf.Bunker && near && has(gogolf.Splash):
+		return gogolf.Splash
+	case near && has(gogolf.Chip):
+		return gogolf.Chip
+	default:
+		return gogolf.FullSwing
+	}
+}
+
+// chooseAim picks the target. On the green the golfer plays the break if they read it; elsewhere
+// they attack the flag when feeling aggressive and otherwise take the game's default target.
+// A golfer who notices that the target brings trouble into play switches to a safer one.
+func (c ComputerPlayer) chooseAim(g *Game, lie gogolf.LieType) gogolf.Aim {
+	t := tiers[c.Difficulty]
+	aims := g.AimPoints()
+	if lie == gogolf.Green {
+		if g.random.Float64() < t.hazardSense {
+			for _, aim := range aims {
+				if aim.Name == gogolf.AimPlayTheBreak {
+					return aim
+				}
+			}
+		}
+		return aims[0]
+	}
+
+	aim := g.DefaultAim()
+	if g.random.Float64() < t.aggression {
+		aim = aims[0]
+	}
+	hole := g.GetCurrentHole()
+	if !bringsTroubleIntoPlay(hole, g.Ball.Location, aim.Target) || g.random.Float64() >= t.hazardSense {
+		return aim
+	}
+	for _, safer := range aims {
+		if !bringsTroubleIntoPlay(hole, g.Ball.Location, safer.Target) {
+			return safer
+		}
+	}
+	return aim
+}
+
+// bringsTroubleIntoPlay reports whether a shot from origin finishing on or either side of target
+// could end up out of bounds, in a penalty area or in a bunker
+func bringsTroubleIntoPlay(h gogolf.Hole, origin, target gogolf.Point) bool {
+	line := origin.Direction(target)
+	spots := []gogolf.Point{target}
+	if line.Magnitude() > 0 {
+		for _, side := range []float64{90, -90} {
+			spots = append(spots, target.Move(line.Rotate(side), float64(troubleRadius.Units())))
+		}
+	}
+	for _, spot := range spots {
+		if h.IsOutOfBounds(spot) {
+			return true
+		}
+		switch h.GetLieAtPosition(spot) {
+		case gogolf.PenaltyArea, gogolf.Bunker:
+			return true
+		}
+	}
+	return false
+}
+
+// ChooseRelief picks how to continue from a penalty area. A golfer who manages the situation
+// well drops where the ball is playable and closest to the hole; otherwise they take the first option.
+func (c ComputerPlayer) ChooseRelief(g *Game, relief Relief) gogolf.ReliefOption {
+	best := relief.Options[0]
+	if g.random.Float64() >= tiers[c.Difficulty].hazardSense {
+		return best.Option
+	}
+	for _, choice := range relief.Options[1:] {
+		playable := choice.Lie != gogolf.PenaltyArea && choice.Lie != gogolf.Bunker
+		bestPlayable := best.Lie != gogolf.PenaltyArea && best.Lie != gogolf.Bunker
+		if (playable && !bestPlayable) || (playable == bestPlayable && choice.DistanceToHole < best.DistanceToHole) {
+			best = choice
+		}
+	}
+	return best.Option
+}
+
+// PlayComputerShot lets the computer decide and play g's next shot through the same pipeline
+// as a human's, taking relief for the golfer if the ball finishes in a penalty area
+func (g *Game) PlayComputerShot() (ShotResult, error) {
+	if g.Computer == nil {
+		return ShotResult{}, fmt.Errorf("%s is not a computer golfer", g.Golfer.Name)
+	}
+	if relief := g.PendingRelief(); relief != nil {
+		if err := g.takeComputerRelief(*relief); err != nil {
+			return ShotResult{}, err
+		}
+	}
+	plan := g.Computer.PlanShot(g)
+	result, err := g.TakeShotWithShape(plan.Power, plan.Shape, plan.Aim)
+	if err != nil {
+		return ShotResult{}, err
+	}
+	if relief := g.PendingRelief(); relief != nil {
+		if err := g.takeComputerRelief(*relief); err != nil {
+			return result, err
+		}
+		result.PenaltyStrokes = g.lastShotResult.PenaltyStrokes
+	}
+	return result, nil
+}
+
+// takeComputerRelief drops the ball where the computer chooses to take relief
+func (g *Game) takeComputerRelief(relief Relief) error {
+	if len(relief.Options) == 0 {
+		return fmt.Errorf("%s has no relief option to take", g.Golfer.Name)
+	}
+	return g.TakeRelief(g.Computer.ChooseRelief(g, relief))
+}
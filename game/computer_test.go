@@ -0,0 +1,137 @@
+package game
+
+import (
+	"gogolf"
+	"testing"
+)
+
+func newComputerGame(difficulty Difficulty, holes int, seed uint64) *Game {
+	g := NewFromGolferWithSeed(NewComputerGolfer("CPU", difficulty), holes, seed)
+	g.Computer = &ComputerPlayer{Difficulty: difficulty}
+	g.Weather = gogolf.CalmWeather()
+	return g
+}
+
+func TestNewComputerGolferLevelsByDifficulty(t *testing.T) {
+	beginner := NewComputerGolfer("A", Beginner)
+	pro := NewComputerGolfer("B", TourPro)
+
+	for name, skill := range pro.Skills {
+		if skill.Level <= beginner.Skills[name].Level {
+			t.Errorf("%s: pro level %d should be above beginner level %d", name, skill.Level, beginner.Skills[name].Level)
+		}
+	}
+	for name, ability := range pro.Abilities {
+		if ability.Level <= beginner.Abilities[name].Level {
+			t.Errorf("%s: pro level %d should be above beginner level %d", name, ability.Level, beginner.Abilities[name].Level)
+		}
+	}
+}
+
+func TestPlayComputerShotNeedsAComputer(t *testing.T) {
+	g := NewWithSeed("Human", 1, 1)
+	if _, err := g.PlayComputerShot(); err == nil {
+		t.Error("expected an error asking a human golfer's game to play itself")
+	}
+}
+
+func TestPlayComputerShotReportsReliefItCannotTake(t *testing.T) {
+	g := newGameWithCreek(t)
+	g.Computer = &ComputerPlayer{Difficulty: ClubGolfer}
+	g.pendingRelief = &Relief{}
+
+	if _, err := g.PlayComputerShot(); err == nil {
+		t.Error("expected an error when no relief option can be taken")
+	}
+	if g.StrokesThisHole() != 0 {
+		t.Errorf("no shot should be played before relief is taken, got %d strokes", g.StrokesThisHole())
+	}
+}
+
+func TestComputerPuttsWithMeasuredPower(t *testing.T) {
+	g := newGameOnGreen(t, gogolf.SlopeDefinition{})
+	g.Computer = &ComputerPlayer{Difficulty: TourPro}
+
+	plan := g.Computer.PlanShot(g)
+
+	if plan.Club != "Putter" || plan.Shape != gogolf.Straight {
+		t.Errorf("on the green the plan should be a straight putt, got %+v", plan)
+	}
+	if plan.Power <= 0 || plan.Power >= 1 {
+		t.Errorf("a short putt should be struck with part power, got %.2f", plan.Power)
+	}
+}
+
+func TestComputerPlansAreDeterministic(t *testing.T) {
+	first := newComputerGame(ClubGolfer, 1, 21)
+	second := newComputerGame(ClubGolfer, 1, 21)
+
+	for range 3 {
+		a, err := first.PlayComputerShot()
+		if err != nil {
+			t.Fatalf("PlayComputerShot returned error: %v", err)
+		}
+		b, err := second.PlayComputerShot()
+		if err != nil {
+			t.Fatalf("PlayComputerShot returned error: %v", err)
+		}
+		if a.ClubName != b.ClubName || a.Power != b.Power || a.Aim != b.Aim || first.Ball != second.Ball {
+			t.Fatalf("the same seed should play the same shots: %+v vs %+v", a, b)
+		}
+	}
+}
+
+func TestComputerAvoidsTrouble(t *testing.T) {
+	g := newGameWithCreek(t)
+	hole := g.GetCurrentHole()
+
+	if !bringsTroubleIntoPlay(hole, g.Ball.Location, at(40, 160)) {
+		t.Error("a target in the creek brings it into play")
+	}
+	if bringsTroubleIntoPlay(hole, g.Ball.Location, at(40, 250)) {
+		t.Error("a target on open fairway is safe")
+	}
+}
+
+func TestComputerTakesTheBestRelief(t *testing.T) {
+	g := newGameWithCreek(t)
+	relief := Relief{Options: []ReliefChoice{
+		{Option: gogolf.StrokeAndDistance, Lie: gogolf.Tee, DistanceToHole: 285},
+		{Option: gogolf.LateralRelief, Lie: gogolf.PenaltyArea, DistanceToHole: 120},
+		{Option: gogolf.BackOnTheLine, Lie: gogolf.Fairway, DistanceToHole: 140},
+	}}
+
+	picked := map[gogolf.ReliefOption]int{}
+	for range 20 {
+		picked[ComputerPlayer{Difficulty: TourPro}.ChooseRelief(g, relief)]++
+	}
+	if picked[gogolf.BackOnTheLine] < 15 || picked[gogolf.LateralRelief] > 0 {
+		t.Errorf("a tour pro should almost always drop on the fairway, got %v", picked)
+	}
+}
+
+func TestComputerPlaysARound(t *testing.T) {
+	total := map[Difficulty]int{}
+	for _, difficulty := range Difficulties() {
+		for seed := uint64(1); seed <= 3; seed++ {
+			g := newComputerGame(difficulty, 3, seed)
+			for !g.IsRoundComplete() {
+				g.TeeUp()
+				for shots := 0; !g.IsHoleComplete(); shots++ {
+					if shots > 20 {
+						t.Fatalf("%s: hole %d never finished", difficulty, g.GetCurrentHole().Number)
+					}
+					if _, err := g.PlayComputerShot(); err != nil {
+						t.Fatalf("PlayComputerShot returned error: %v", err)
+					}
+				}
+				g.CompleteHole()
+				g.NextHole()
+			}
+			total[difficulty] += g.ScoreCard.TotalStrokes()
+		}
+	}
+	if total[TourPro] >= total[Beginner] {
+		t.Errorf("a tour pro should beat a beginner: %v", total)
+	}
+}
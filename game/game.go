@@ -1,6 +1,7 @@
 package game
 
 import (
+	"fmt"
 	"gogolf"
 	"math"
 	"math/rand/v2"
@@ -12,8 +13,25 @@ type Game struct {
 	Ball             gogolf.GolfBall
 	ScoreCard        gogolf.ScoreCard
 	CurrentHoleIndex int
-	random           gogolf.RandomSource
-	lastShotResult   *ShotResult
+	// Scoring is the format the round is scored and paid in; nil plays stroke play
+	Scoring gogolf.ScoringFormat
+	// Computer decides and plays the golfer's shots when set; nil for a golfer at the keyboard
+	Computer *ComputerPlayer
+	// Caddie picks the club when the golfer has not chosen one; nil makes the golfer's usual pick
+	Caddie         gogolf.ClubSelector
+	Weather        gogolf.Weather
+	random         gogolf.RandomSource
+	lastShotResult *ShotResult
+	pendingRelief  *Relief
+	selectedClub   *gogolf.Club
+	shotType       gogolf.ShotType
+	trajectory     gogolf.Trajectory
+	spin           gogolf.Spin
+	// puttConceded marks a hole finished by a conceded putt
+	puttConceded bool
+	// simulated marks a game played only to measure the golfer, such as building a baseline; its
+	// shots award no experience, so the golfer stays at the level being measured
+	simulated bool
 }
 
 type Context struct {
@@ -23,10 +41,20 @@ type Context struct {
 	ScoreCard   gogolf.ScoreCard
 	CurrentClub gogolf.Club
 	Lie         gogolf.LieType
+	Weather     gogolf.Weather
+	// PlaysLike is the distance to the hole allowing for the change in elevation
+	PlaysLike gogolf.Yard
+	Stance    gogolf.Stance
+	Scoring   gogolf.ScoringFormat
+	// Points is the golfer's result in Scoring over the holes finished
+	Points int
 }
 
 type ShotResult struct {
 	ClubName      string
+	ShotType      gogolf.ShotType
+	Trajectory    gogolf.Trajectory
+	Spin          gogolf.Spin
 	IntendedShape gogolf.ShotShape
 	ActualShape   gogolf.ShotShape
 	ShapeSuccess  bool
@@ -38,46 +66,85 @@ type ShotResult struct {
 	Rotation      float64
 	RotationDir   string
 	Power         float64
-	Distance      float64
-	XPEarned      int
-	LevelUps      []string
-	HoledOut      bool
-	TapIn         bool
+	Aim           gogolf.Aim
+	// Apex is how many feet the ball climbed at the top of its flight
+	Apex        float64
+	SpinOutcome gogolf.SpinOutcome
+	// OffLine is how many yards right (positive) or left (negative) of the aim line the ball finished
+	OffLine     float64
+	Carry       float64
+	Roll        float64
+	Distance    float64
+	ObstacleHit *gogolf.ObstacleCollision
+	Conditions  gogolf.Conditions
+	Stance      gogolf.Stance
+	Penalty     gogolf.PenaltyKind
+	// PenaltyStrokes counts penalty strokes this shot has cost so far, including relief taken afterwards
+	PenaltyStrokes int
+	XPEarned       int
+	LevelUps       []string
+	HoledOut       bool
+	TapIn          bool
 }
 
 func New(playerName string, holeCount int) *Game {
-	rng := rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
-	return NewWithRandom(playerName, holeCount, rng)
+	return NewWithRandom(playerName, holeCount, newRandom(rand.Uint64(), rand.Uint64()))
+}
+
+// NewSeededRandom returns a random source whose draws are fully determined by seed
+func NewSeededRandom(seed uint64) gogolf.RandomSource {
+	return newRandom(seed, seed)
+}
+
+func NewWithSeed(playerName string, holeCount int, seed uint64) *Game {
+	return NewFromGolferWithSeed(gogolf.NewGolfer(playerName), holeCount, seed)
 }
 
 func NewWithRandom(playerName string, holeCount int, rng gogolf.RandomSource) *Game {
-	course, scoreCard := gogolf.GenerateSimpleCourse(holeCount)
-	return &Game{
-		Golfer:           gogolf.NewGolfer(playerName),
-		Course:           course,
-		Ball:             gogolf.GolfBall{Location: gogolf.Point{X: 0, Y: 0}},
-		ScoreCard:        scoreCard,
-		CurrentHoleIndex: 0,
-		random:           rng,
-	}
+	return NewFromGolferWithRandom(gogolf.NewGolfer(playerName), holeCount, rng)
 }
 
 func NewFromGolfer(golfer gogolf.Golfer, holeCount int) *Game {
-	rng := rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
-	course, scoreCard := gogolf.GenerateSimpleCourse(holeCount)
-	return &Game{
+	return NewFromGolferWithRandom(golfer, holeCount, newRandom(rand.Uint64(), rand.Uint64()))
+}
+
+// NewFromGolferWithSeed generates the course and plays the round from the same seed,
+// so sharing a seed shares both the layout and every shot's randomness
+func NewFromGolferWithSeed(golfer gogolf.Golfer, holeCount int, seed uint64) *Game {
+	return NewWithCourse(golfer, gogolf.GenerateCourse(holeCount, seed), NewSeededRandom(seed))
+}
+
+// NewFromGolferWithRandom creates a game where every random draw in the round
+// (course layout, dice, rotation, shape and curve) comes from rng
+func NewFromGolferWithRandom(golfer gogolf.Golfer, holeCount int, rng gogolf.RandomSource) *Game {
+	courseSeed := uint64(rng.IntN(math.MaxInt))
+	return NewWithCourse(golfer, gogolf.GenerateCourse(holeCount, courseSeed), rng)
+}
+
+// NewWithCourse creates a game played over the given course, such as one read by gogolf.LoadCourse
+func NewWithCourse(golfer gogolf.Golfer, course gogolf.Course, rng gogolf.RandomSource) *Game {
+	g := &Game{
 		Golfer:           golfer,
 		Course:           course,
-		Ball:             gogolf.GolfBall{Location: gogolf.Point{X: 0, Y: 0}},
-		ScoreCard:        scoreCard,
+		ScoreCard:        gogolf.NewScoreCard(course),
 		CurrentHoleIndex: 0,
+		Weather:          gogolf.GenerateWeather(rng),
 		random:           rng,
 	}
+	if index, ok := golfer.HandicapIndex(); ok {
+		g.ScoreCard.CourseHandicap = course.CourseHandicap(index)
+	}
+	g.Ball.TeeUpAt(g.GetCurrentHole().TeeLocation)
+	return g
 }
 
 func (g *Game) TeeUp() {
-	g.Ball.TeeUp()
+	g.Ball.TeeUpAt(g.GetCurrentHole().TeeLocation)
 	g.lastShotResult = nil
+	g.pendingRelief = nil
+	g.selectedClub = nil
+	g.puttConceded = false
+	g.resetShotSetup()
 }
 
 func (g *Game) GetCurrentHole() gogolf.Hole {
@@ -87,7 +154,7 @@ func (g *Game) GetCurrentHole() gogolf.Hole {
 func (g *Game) GetContext() Context {
 	hole := g.GetCurrentHole()
 	lie := g.Ball.GetLie(&hole)
-	club := g.Golfer.GetBestClubForLie(g.Ball.Location.Distance(hole.HoleLocation).Yards(), lie)
+	club := g.CurrentClub()
 
 	return Context{
 		Golfer:      g.Golfer,
@@ -96,19 +163,192 @@ func (g *Game) GetContext() Context {
 		ScoreCard:   g.ScoreCard,
 		CurrentClub: club,
 		Lie:         lie,
+		Weather:     g.Weather,
+		PlaysLike:   hole.PlaysLike(g.Ball.Location, hole.HoleLocation),
+		Stance:      g.stanceFor(hole, lie, g.Ball.Location, g.Ball.Location.Direction(hole.HoleLocation)),
+		Scoring:     g.ScoringFormat(),
+		Points:      g.Points(),
 	}
 }
 
-func (g *Game) TakeShot(power float64) ShotResult {
-	return g.TakeShotWithShape(power, gogolf.Straight)
+// ClubOption is a club in the bag with how far it is expected to carry and run with a full swing
+type ClubOption struct {
+	Club  gogolf.Club
+	Carry gogolf.Yard
+	Total gogolf.Yard
 }
 
-func (g *Game) TakeShotWithShape(power float64, shape gogolf.ShotShape) ShotResult {
+// SuggestClub asks selector which club to play from where the ball lies, judged on the distance
+// the hole plays allowing for elevation; nil makes the golfer's usual pick
+func (g *Game) SuggestClub(selector gogolf.ClubSelector) gogolf.Club {
 	hole := g.GetCurrentHole()
+	distance := hole.PlaysLike(g.Ball.Location, hole.HoleLocation)
+	return g.Golfer.SelectClubForLie(selector, distance, g.Ball.GetLie(&hole))
+}
+
+// CurrentClub is the club the next shot will be played with: the golfer's choice if they
+// have made one, otherwise the caddie's
+func (g *Game) CurrentClub() gogolf.Club {
+	if g.selectedClub != nil {
+		return *g.selectedClub
+	}
+	return g.SuggestClub(g.Caddie)
+}
+
+// SelectClub chooses the club for the next shot by name. The choice lasts until the ball is struck.
+func (g *Game) SelectClub(name string) error {
+	for _, club := range g.Golfer.Clubs {
+		if club.Name == name {
+			g.selectedClub = &club
+			return nil
+		}
+	}
+	return fmt.Errorf("no club named %q in the bag", name)
+}
+
+// ShotTypes lists the shot types that can be played with the current club from where the ball lies
+func (g *Game) ShotTypes() []gogolf.ShotType {
+	hole := g.GetCurrentHole()
+	return gogolf.AvailableShotTypes(g.CurrentClub(), g.Ball.GetLie(&hole))
+}
+
+// CurrentShotType is the shot type the next shot will be played with. A chosen type that the
+// current club or lie does not allow falls back to a full swing.
+func (g *Game) CurrentShotType() gogolf.ShotType {
+	hole := g.GetCurrentHole()
+	if g.shotType.Allows(g.CurrentClub(), g.Ball.GetLie(&hole)) {
+		return g.shotType
+	}
+	return gogolf.FullSwing
+}
+
+// SelectShotType chooses the shot type for the next shot. The choice lasts until the ball is struck.
+func (g *Game) SelectShotType(shotType gogolf.ShotType) error {
+	hole := g.GetCurrentHole()
+	club := g.CurrentClub()
 	lie := g.Ball.GetLie(&hole)
-	club := g.Golfer.GetBestClubForLie(g.Ball.Location.Distance(hole.HoleLocation).Yards(), lie)
-	directionToHole := g.Ball.Location.Direction(hole.HoleLocation)
-	difficulty := lie.DifficultyModifier()
+	if !shotType.Allows(club, lie) {
+		return fmt.Errorf("cannot play a %s with the %s from the %s", shotType, club.Name, lie)
+	}
+	g.shotType = shotType
+	return nil
+}
+
+// CurrentTrajectory is the trajectory the next shot will be flighted on; putts always roll along the ground
+func (g *Game) CurrentTrajectory() gogolf.Trajectory {
+	if g.CurrentClub().Name == "Putter" {
+		return gogolf.StandardTrajectory
+	}
+	return g.trajectory
+}
+
+// SelectTrajectory chooses how high to flight the next shot. The choice lasts until the ball is struck.
+func (g *Game) SelectTrajectory(trajectory gogolf.Trajectory) error {
+	if trajectory != gogolf.StandardTrajectory && g.CurrentClub().Name == "Putter" {
+		return fmt.Errorf("cannot hit a %s trajectory with the Putter", trajectory)
+	}
+	g.trajectory = trajectory
+	return nil
+}
+
+// CurrentSpin is the spin the next shot will be played with; putts are struck without spin control
+func (g *Game) CurrentSpin() gogolf.Spin {
+	if g.CurrentClub().Name == "Putter" {
+		return gogolf.Check
+	}
+	return g.spin
+}
+
+// SelectSpin chooses the spin for the next shot. The choice lasts until the ball is struck.
+func (g *Game) SelectSpin(spin gogolf.Spin) error {
+	if spin != gogolf.Check && g.CurrentClub().Name == "Putter" {
+		return fmt.Errorf("cannot play %s with the Putter", spin)
+	}
+	g.spin = spin
+	return nil
+}
+
+// resetShotSetup clears the shot type, trajectory and spin chosen for a shot
+func (g *Game) resetShotSetup() {
+	g.shotType = gogolf.FullSwing
+	g.trajectory = gogolf.StandardTrajectory
+	g.spin = gogolf.Check
+}
+
+// ClubOptions lists every club in the bag with its projected full-swing carry
+func (g *Game) ClubOptions() []ClubOption {
+	options := make([]ClubOption, 0, len(g.Golfer.Clubs))
+	for _, club := range g.Golfer.Clubs {
+		modified := g.Golfer.GetModifiedClub(club)
+		options = append(options, ClubOption{
+			Club:  club,
+			Carry: modified.Distance * gogolf.Yard(modified.CarryFraction()),
+			Total: modified.Distance,
+		})
+	}
+	return options
+}
+
+// AimPoints lists the named targets for the ball where it lies with the club that would be used
+func (g *Game) AimPoints() []gogolf.Aim {
+	ctx := g.GetContext()
+	reach := g.Golfer.GetModifiedClub(ctx.CurrentClub).Distance
+	return ctx.Hole.AimPoints(g.Ball.Location, reach)
+}
+
+// ReadGreen reads the putt from where the ball lies to the hole
+func (g *Game) ReadGreen() gogolf.GreenRead {
+	return g.GetContext().Hole.ReadPutt(g.Ball.Location)
+}
+
+// DefaultAim is the center of the fairway when there is one to aim at, otherwise the flag
+func (g *Game) DefaultAim() gogolf.Aim {
+	aims := g.AimPoints()
+	for _, aim := range aims {
+		if aim.Name == gogolf.AimCenterFairway {
+			return aim
+		}
+	}
+	return aims[0]
+}
+
+func (g *Game) TakeShot(power float64, aim gogolf.Aim) (ShotResult, error) {
+	return g.TakeShotWithShape(power, gogolf.Straight, aim)
+}
+
+// TakeShotWithShape plays the ball from where it lies toward aim. Power is a share of the aim's
+// planned distance, so a full swing at an aim shorter than the club's carry stops short.
+// A ball in a penalty area cannot be played until the golfer has chosen relief.
+func (g *Game) TakeShotWithShape(power float64, shape gogolf.ShotShape, aim gogolf.Aim) (ShotResult, error) {
+	if g.pendingRelief != nil {
+		return ShotResult{}, fmt.Errorf("relief must be taken before the next shot: ball is in a penalty area")
+	}
+	hole := g.GetCurrentHole()
+	lie := g.Ball.GetLie(&hole)
+	club := g.CurrentClub()
+	shotType := g.CurrentShotType()
+	trajectory := g.CurrentTrajectory()
+	spin := g.CurrentSpin()
+	g.selectedClub = nil
+	g.resetShotSetup()
+	origin := g.Ball.Location
+	aimDirection := aim.Direction(origin)
+	if aimDirection.Magnitude() == 0 {
+		aim = gogolf.NewAim(gogolf.AimFlag, hole.HoleLocation)
+		aimDirection = aim.Direction(origin)
+	}
+	g.Golfer.Target = aim.Target
+	stance := g.stanceFor(hole, lie, origin, aimDirection)
+	conditions := g.Weather.ForShot(g.random)
+	difficulty := lie.DifficultyModifier() + conditions.DifficultyModifier(club) + shotType.DifficultyModifier(lie) +
+		trajectory.DifficultyModifier(lie)
+	if hole.UnderCover(origin) {
+		difficulty += shotType.UnderCoverModifier()
+	}
+	// Uneven ground makes the swing harder; putts are struck from a level stance
+	if club.Name != "Putter" {
+		difficulty += stance.DifficultyModifier()
+	}
 
 	var targetNumber int
 	if club.Name == "Putter" {
@@ -116,25 +356,29 @@ func (g *Game) TakeShotWithShape(power float64, shape gogolf.ShotShape) ShotResu
 	} else {
 		targetNumber = g.Golfer.CalculateTargetNumberWithShape(club, difficulty, shape)
 	}
-	result := g.Golfer.SkillCheck(gogolf.NewD6(), targetNumber)
+	result := g.Golfer.SkillCheck(gogolf.NewD6WithRandom(g.random), targetNumber)
 
 	rotationDirection := float64(1)
 	if int(math.Abs(float64(result.Margin)))%2 == 0 {
 		rotationDirection *= -1
 	}
 
-	modifiedClub := g.Golfer.GetModifiedClub(club)
+	modifiedClub := trajectory.Apply(shotType.Apply(g.Golfer.GetModifiedClub(club)))
 
-	rotationDegrees := gogolf.CalculateRotation(modifiedClub, result, g.random)
-	adjustedPower := gogolf.CalculatePower(modifiedClub, power, result)
+	rotationDegrees := gogolf.CalculateRotationWithType(modifiedClub, result, shotType, g.random)
+	adjustedPower := gogolf.CalculatePowerWithType(modifiedClub, power, result, shotType)
+	adjustedPower *= float64(aim.PlannedDistance(modifiedClub) / modifiedClub.Distance)
 
 	skill := g.Golfer.GetSkillForClub(club)
 	ability := g.Golfer.GetAbilityForClub(club)
 	prevSkillLevel := skill.Level
 	prevAbilityLevel := ability.Level
 
-	xpAward := calculateXP(result.Outcome)
-	g.Golfer.AwardExperience(club, xpAward)
+	xpAward := 0
+	if !g.simulated {
+		xpAward = calculateXP(result.Outcome)
+		g.Golfer.AwardExperience(club, xpAward)
+	}
 
 	newSkill := g.Golfer.GetSkillForClub(club)
 	newAbility := g.Golfer.GetAbilityForClub(club)
@@ -147,17 +391,21 @@ func (g *Game) TakeShotWithShape(power float64, shape gogolf.ShotShape) ShotResu
 		levelUps = append(levelUps, newAbility.Name+" leveled up!")
 	}
 
-	directionToHole.Rotate(rotationDegrees * rotationDirection)
-	ballPath := g.Ball.ReceiveHit(modifiedClub, float32(adjustedPower), directionToHole)
+	shotDirection := aimDirection.Rotate(rotationDegrees * rotationDirection)
+	carryFraction := trajectory.CarryFraction(shotType.CarryFraction(modifiedClub))
+	ballPath := g.Ball.ReceiveHit(modifiedClub, float32(adjustedPower*carryFraction), shotDirection, conditions)
 
 	var shapeResult gogolf.ShapeResult
 	if club.Name != "Putter" {
 		shapeResult = gogolf.DetermineActualShape(shape, result, g.random)
-		g.applyShape(ballPath, hole, shapeResult)
+		g.applyShape(ballPath, aimDirection, shapeResult)
 	} else {
 		shapeResult = gogolf.ShapeResult{Intended: shape, Actual: gogolf.Straight, Success: true}
 	}
 
+	flight, collision := g.completeFlight(hole, modifiedClub, adjustedPower, carryFraction, spin, shotDirection, conditions)
+
+	penaltiesBefore := g.ScoreCard.PenaltiesThisHole(hole)
 	g.ScoreCard.RecordStroke(hole)
 
 	rotationDir := "right"
@@ -165,39 +413,177 @@ func (g *Game) TakeShotWithShape(power float64, shape gogolf.ShotShape) ShotResu
 		rotationDir = "left"
 	}
 
-	holedOut := hole.DetectHoleOut(g.Ball, ballPath)
-	tapIn := !holedOut && hole.DetectTapIn(g.Ball)
+	holedOut := hole.DetectHoleOutOnFlight(flight)
+	penalty := gogolf.NoPenalty
+	if !holedOut {
+		penalty = g.applyPenalties(hole, flight)
+	}
+	tapIn := !holedOut && penalty == gogolf.NoPenalty && hole.DetectTapIn(g.Ball)
+
+	shot := gogolf.ShotRecord{
+		Club:      club.Name,
+		StartLie:  lie,
+		EndLie:    hole.GetLieAtPosition(flight.Rest),
+		FromHole:  origin.Distance(hole.HoleLocation).Yards(),
+		ToHole:    g.Ball.Location.Distance(hole.HoleLocation).Yards(),
+		Distance:  flight.TotalDistance(),
+		Outcome:   result.Outcome,
+		Penalties: g.ScoreCard.PenaltiesThisHole(hole) - penaltiesBefore,
+		Putt:      lie == gogolf.Green,
+		HoledOut:  holedOut,
+	}
+	if holedOut {
+		shot.ToHole = 0
+	}
+	g.ScoreCard.AddShot(hole, shot)
 
 	if tapIn {
 		holedOut = true
 		g.ScoreCard.RecordStroke(hole)
+		g.ScoreCard.AddShot(hole, gogolf.ShotRecord{
+			Club:     "Putter",
+			StartLie: shot.EndLie,
+			EndLie:   gogolf.Green,
+			FromHole: shot.ToHole,
+			Distance: shot.ToHole,
+			Outcome:  gogolf.Good,
+			Putt:     shot.EndLie == gogolf.Green,
+			HoledOut: true,
+		})
 	}
 
 	shotResult := ShotResult{
-		ClubName:      club.Name,
-		IntendedShape: shapeResult.Intended,
-		ActualShape:   shapeResult.Actual,
-		ShapeSuccess:  shapeResult.Success,
-		Outcome:       result.Outcome,
-		Margin:        result.Margin,
-		TargetNumber:  targetNumber,
-		DiceRolls:     result.Rolls,
-		Description:   gogolf.GetShotQualityDescription(result),
-		Rotation:      rotationDegrees,
-		RotationDir:   rotationDir,
-		Power:         power,
-		Distance:      float64(gogolf.Unit(ballPath.Magnitude()).Yards()),
-		XPEarned:      xpAward,
-		LevelUps:      levelUps,
-		HoledOut:      holedOut,
-		TapIn:         tapIn,
+		ClubName:       club.Name,
+		ShotType:       shotType,
+		Trajectory:     trajectory,
+		Spin:           spin,
+		IntendedShape:  shapeResult.Intended,
+		ActualShape:    shapeResult.Actual,
+		ShapeSuccess:   shapeResult.Success,
+		Outcome:        result.Outcome,
+		Margin:         result.Margin,
+		TargetNumber:   targetNumber,
+		DiceRolls:      result.Rolls,
+		Description:    gogolf.GetShotQualityDescription(result),
+		Rotation:       rotationDegrees,
+		RotationDir:    rotationDir,
+		Power:          power,
+		Aim:            aim,
+		OffLine:        float64(aim.OffLine(origin, flight.Rest)),
+		Carry:          float64(flight.CarryDistance()),
+		Roll:           float64(flight.RollDistance()),
+		Distance:       float64(flight.TotalDistance()),
+		Apex:           float64(flight.Apex),
+		SpinOutcome:    flight.Spin,
+		ObstacleHit:    collision,
+		Conditions:     conditions,
+		Stance:         stance,
+		Penalty:        penalty,
+		PenaltyStrokes: g.ScoreCard.PenaltiesThisHole(hole) - penaltiesBefore,
+		XPEarned:       xpAward,
+		LevelUps:       levelUps,
+		HoledOut:       holedOut,
+		TapIn:          tapIn,
 	}
 
 	g.lastShotResult = &shotResult
-	return shotResult
+	return shotResult, nil
+}
+
+// completeFlight checks the carry and then the roll for trees and other obstacles.
+// A ball stopped in the air never gets to roll out.
+func (g *Game) completeFlight(h gogolf.Hole, club gogolf.Club, power float64, carryFraction float64, spin gogolf.Spin, aim gogolf.Vector, conditions gogolf.Conditions) (gogolf.Flight, *gogolf.ObstacleCollision) {
+	origin := g.Ball.PrevLocation
+	g.landOnTerrain(h)
+	apex := club.ApexHeight(origin.Distance(g.Ball.Location).Yards())
+
+	if collision := h.CheckObstacles(origin, g.Ball.Location, apex, g.random); collision != nil {
+		g.Ball.Location = collision.Rest
+		return gogolf.Flight{Origin: origin, Landing: collision.Rest, Rest: collision.Rest, Apex: apex}, collision
+	}
+
+	flight := g.rollOut(h, club, power, carryFraction, spin, aim, conditions)
+	flight.Apex = apex
+
+	path := flight.RollPath
+	if len(path) < 2 {
+		path = []gogolf.Point{flight.Landing, flight.Rest}
+	}
+	collision, rolled := h.CheckObstaclesOnPath(path, g.random)
+	if collision != nil {
+		g.Ball.Location = collision.Rest
+		flight.Rest = collision.Rest
+		if flight.RollPath != nil {
+			flight.RollPath = rolled
+		}
+	}
+	return flight, collision
+}
+
+// rollOut runs the ground phase of a shot from wherever the carry landed. How far the
+// ball releases depends on the landing lie, the club's loft, the spin played, the ball's
+// spin control and how wet the ground is. A ball that spins back rolls toward the golfer.
+func (g *Game) rollOut(h gogolf.Hole, club gogolf.Club, power float64, carryFraction float64, spin gogolf.Spin, aim gogolf.Vector, conditions gogolf.Conditions) gogolf.Flight {
+	landing := g.Ball.Location
+	direction := g.Ball.PrevLocation.Direction(landing)
+	if direction.Magnitude() == 0 {
+		direction = aim
+	}
+
+	rollFactor := 1.0
+	outcome := gogolf.NoSpinEffect
+	if club.Name != "Putter" {
+		rollFactor, outcome = gogolf.RollFactorWithSpin(h.GetLieAtPosition(landing), club, g.spinControl(), spin)
+	}
+	nominalRoll := float64(club.Distance) * power * (1 - carryFraction) * conditions.RollMultiplier()
+	roll := gogolf.Yard(nominalRoll * rollFactor)
+	if roll < 0 {
+		direction = gogolf.Vector{X: -direction.X, Y: -direction.Y}
+		roll = -roll
+	}
+
+	if h.Grid != nil && h.GetLieAtPosition(landing) == gogolf.Green {
+		// on the putting surface the slope and green speed shape the roll
+		path := h.RollOnGreen(landing, direction, roll)
+		g.Ball.Location = path[len(path)-1]
+		return gogolf.Flight{Origin: g.Ball.PrevLocation, Landing: landing, Rest: g.Ball.Location, RollPath: path, Spin: outcome}
+	}
+	g.Ball.RollOut(direction, roll.Units())
+
+	return gogolf.Flight{Origin: g.Ball.PrevLocation, Landing: landing, Rest: g.Ball.Location, Spin: outcome}
+}
+
+// landOnTerrain pulls the landing spot back when the ground rises to meet the ball
+// and lets it fly on when the ground falls away
+func (g *Game) landOnTerrain(h gogolf.Hole) {
+	origin := g.Ball.PrevLocation
+	carry := origin.Distance(g.Ball.Location).Yards()
+	if carry == 0 {
+		return
+	}
+	ground := gogolf.GroundDistance(carry, h.ElevationChange(origin, g.Ball.Location))
+	if ground != carry {
+		g.Ball.Location = origin.Move(origin.Direction(g.Ball.Location), float64(ground.Units()))
+	}
+}
+
+// stanceFor is the stance for a shot from pos along direction. Balls on the tee are teed up
+// on level ground and putts are struck without a full swing, so both play from a level stance.
+func (g *Game) stanceFor(h gogolf.Hole, lie gogolf.LieType, pos gogolf.Point, direction gogolf.Vector) gogolf.Stance {
+	if lie == gogolf.Tee || lie == gogolf.Green {
+		return gogolf.Stance{}
+	}
+	return h.StanceAt(pos, direction)
 }
 
-func (g *Game) applyShape(ballPath gogolf.Vector, h gogolf.Hole, shapeResult gogolf.ShapeResult) {
+func (g *Game) spinControl() float32 {
+	if g.Golfer.Ball == nil {
+		return 0
+	}
+	return g.Golfer.Ball.SpinControl
+}
+
+func (g *Game) applyShape(ballPath gogolf.Vector, aimDirection gogolf.Vector, shapeResult gogolf.ShapeResult) {
 	if shapeResult.Actual == gogolf.Straight {
 		return
 	}
@@ -222,14 +608,12 @@ func (g *Game) applyShape(ballPath gogolf.Vector, h gogolf.Hole, shapeResult gog
 		intensity *= 1.3
 	}
 
-	g.applyCurve(ballPath, h, direction, intensity)
+	g.applyCurve(ballPath, aimDirection, direction, intensity)
 }
 
-func (g *Game) applyCurve(ballPath gogolf.Vector, h gogolf.Hole, direction float64, intensity float64) {
-	directionToHole := g.Ball.PrevLocation.Direction(h.HoleLocation)
-
+func (g *Game) applyCurve(ballPath gogolf.Vector, aimDirection gogolf.Vector, direction float64, intensity float64) {
 	baseRotation := 30.0 * direction
-	if directionToHole.Y < 0 {
+	if aimDirection.Y < 0 {
 		baseRotation *= -1
 	}
 
@@ -239,6 +623,9 @@ func (g *Game) applyCurve(ballPath gogolf.Vector, h gogolf.Hole, direction float
 }
 
 func (g *Game) IsHoleComplete() bool {
+	if g.puttConceded || g.ScoreCard.IsPickedUp(g.GetCurrentHole()) {
+		return true
+	}
 	if g.lastShotResult != nil && g.lastShotResult.HoledOut {
 		return true
 	}
@@ -260,11 +647,86 @@ func (g *Game) NextHole() {
 	}
 }
 
-func (g *Game) CompleteHole() int {
+// HasHoledOut reports whether the ball is in the hole, counting a conceded putt as holed
+func (g *Game) HasHoledOut() bool {
+	return g.puttConceded || (g.lastShotResult != nil && g.lastShotResult.HoledOut)
+}
+
+// ConcedePutt finishes the hole as though the next putt was holed, counting the stroke.
+// In match play an opponent may concede a putt rather than watch it played.
+func (g *Game) ConcedePutt() error {
+	if g.IsHoleComplete() {
+		return fmt.Errorf("hole %d is already finished", g.GetCurrentHole().Number)
+	}
+	if g.pendingRelief != nil {
+		return fmt.Errorf("relief must be taken before a putt can be conceded")
+	}
 	hole := g.GetCurrentHole()
-	strokes := g.StrokesThisHole()
-	g.Golfer.AwardHoleReward(hole.Par, strokes)
-	return gogolf.CalculateHoleReward(hole.Par, strokes)
+	lie := g.Ball.GetLie(&hole)
+	distance := g.Ball.Location.Distance(hole.HoleLocation).Yards()
+	g.ScoreCard.RecordStroke(hole)
+	g.ScoreCard.AddShot(hole, gogolf.ShotRecord{
+		Club:     "Putter",
+		StartLie: lie,
+		EndLie:   gogolf.Green,
+		FromHole: distance,
+		Distance: distance,
+		Outcome:  gogolf.Good,
+		Putt:     lie == gogolf.Green,
+		HoledOut: true,
+	})
+	g.puttConceded = true
+	return nil
+}
+
+// PickUp ends the hole without holing out, as when a hole is conceded or already lost in match play.
+// The scorecard keeps the strokes taken so far and marks the hole as picked up, and the hole
+// earns no reward.
+func (g *Game) PickUp() {
+	if !g.IsHoleComplete() {
+		g.pendingRelief = nil
+		g.ScoreCard.RecordPickUp(g.GetCurrentHole())
+	}
+}
+
+// ScoringFormat is the format the round is scored in, stroke play unless Scoring is set
+func (g *Game) ScoringFormat() gogolf.ScoringFormat {
+	if g.Scoring == nil {
+		return gogolf.StrokePlay{}
+	}
+	return g.Scoring
+}
+
+// Points is the golfer's result in the round's scoring format over the holes finished so far
+func (g *Game) Points() int {
+	finished := g.holesFinished()
+	if finished == 0 {
+		return 0
+	}
+	return g.ScoringFormat().Score([]gogolf.ScoreCard{g.ScoreCard}, g.Course.Holes[finished-1].Number)[0]
+}
+
+// PostScore enters the finished round in the golfer's scoring record for their handicap index
+func (g *Game) PostScore() (gogolf.PostedScore, error) {
+	if !g.IsRoundComplete() {
+		return gogolf.PostedScore{}, fmt.Errorf("the round is not complete")
+	}
+	return g.Golfer.PostScore(g.ScoreCard)
+}
+
+// CompleteHole pays the golfer for the hole as the scoring format rewards it and returns the amount
+func (g *Game) CompleteHole() int {
+	reward := g.ScoringFormat().HoleRewards([]gogolf.ScoreCard{g.ScoreCard}, g.GetCurrentHole())[0]
+	return g.payHoleReward(reward)
+}
+
+// payHoleReward pays the golfer for the hole just finished; a hole that was picked up earns nothing
+func (g *Game) payHoleReward(reward int) int {
+	if g.ScoreCard.IsPickedUp(g.GetCurrentHole()) {
+		return 0
+	}
+	g.Golfer.AddMoney(reward)
+	return reward
 }
 
 func (g *Game) GetLastShotResult() *ShotResult {
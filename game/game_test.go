@@ -3,9 +3,30 @@ package game
 import (
 	"gogolf"
 	"math/rand/v2"
+	"reflect"
 	"testing"
 )
 
+// takeShot plays a shot the rules allow, failing the test if the game refuses it
+func takeShot(t *testing.T, g *Game, power float64, aim gogolf.Aim) ShotResult {
+	t.Helper()
+	result, err := g.TakeShot(power, aim)
+	if err != nil {
+		t.Fatalf("TakeShot returned error: %v", err)
+	}
+	return result
+}
+
+// takeShotWithShape plays a shaped shot the rules allow, failing the test if the game refuses it
+func takeShotWithShape(t *testing.T, g *Game, power float64, shape gogolf.ShotShape, aim gogolf.Aim) ShotResult {
+	t.Helper()
+	result, err := g.TakeShotWithShape(power, shape, aim)
+	if err != nil {
+		t.Fatalf("TakeShotWithShape returned error: %v", err)
+	}
+	return result
+}
+
 func TestNew(t *testing.T) {
 	g := New("TestPlayer", 3)
 
@@ -24,8 +45,9 @@ func TestGameTeeUp(t *testing.T) {
 	g := New("TestPlayer", 3)
 	g.TeeUp()
 
-	if g.Ball.Location.X != 0 || g.Ball.Location.Y != 0 {
-		t.Errorf("expected ball at origin after tee up, got %+v", g.Ball.Location)
+	tee := g.GetCurrentHole().TeeLocation
+	if g.Ball.Location != tee {
+		t.Errorf("expected ball on the tee at %+v after tee up, got %+v", tee, g.Ball.Location)
 	}
 }
 
@@ -60,10 +82,10 @@ func TestTakeShot(t *testing.T) {
 	g.TeeUp()
 
 	initialStrokes := g.ScoreCard.TotalStrokes()
-	result := g.TakeShot(0.8)
+	result := takeShot(t, g, 0.8, g.DefaultAim())
 
-	if g.ScoreCard.TotalStrokes() != initialStrokes+1 {
-		t.Errorf("expected stroke count to increase by 1")
+	if g.ScoreCard.TotalStrokes() != initialStrokes+1+result.PenaltyStrokes {
+		t.Errorf("expected stroke count to increase by 1 plus %d penalty strokes", result.PenaltyStrokes)
 	}
 	if result.ClubName == "" {
 		t.Error("expected shot result to have club name")
@@ -76,11 +98,33 @@ func TestTakeShot(t *testing.T) {
 	}
 }
 
+func TestTakeShotRecordsTheShot(t *testing.T) {
+	g := NewWithSeed("TestPlayer", 1, 3)
+	g.Weather = gogolf.CalmWeather()
+	g.TeeUp()
+	hole := g.GetCurrentHole()
+	from := g.Ball.Location.Distance(hole.HoleLocation).Yards()
+
+	result := takeShot(t, g, 0.8, g.DefaultAim())
+
+	shots := g.ScoreCard.ShotsThisHole(hole)
+	if len(shots) != 1 {
+		t.Fatalf("expected 1 recorded shot, got %d", len(shots))
+	}
+	shot := shots[0]
+	if shot.Club != result.ClubName || shot.StartLie != gogolf.Tee || shot.Outcome != result.Outcome {
+		t.Errorf("recorded shot %+v does not match the result %+v", shot, result)
+	}
+	if shot.FromHole != from || shot.Distance <= 0 || shot.Putt {
+		t.Errorf("expected a full shot from %.0f yards, got %+v", from, shot)
+	}
+}
+
 func TestTakeShotAwardsXP(t *testing.T) {
 	g := New("TestPlayer", 3)
 	g.TeeUp()
 
-	result := g.TakeShot(1.0)
+	result := takeShot(t, g, 1.0, g.DefaultAim())
 
 	if result.XPEarned <= 0 {
 		t.Error("expected XP to be earned")
@@ -128,6 +172,40 @@ func TestCompleteHoleAwardsMoney(t *testing.T) {
 	}
 }
 
+func TestNewGameAppliesCourseHandicap(t *testing.T) {
+	golfer := gogolf.NewGolfer("TestPlayer")
+	for range 3 {
+		golfer.ScoringRecord = append(golfer.ScoringRecord, gogolf.PostedScore{Holes: 18, AdjustedGross: 90, Rating: 72, Slope: 113})
+	}
+	course := gogolf.GenerateCourse(18, 4)
+
+	g := NewWithCourse(golfer, course, NewSeededRandom(4))
+
+	index, _ := golfer.HandicapIndex()
+	if want := course.CourseHandicap(index); g.ScoreCard.CourseHandicap != want || want == 0 {
+		t.Errorf("CourseHandicap = %d, want %d", g.ScoreCard.CourseHandicap, want)
+	}
+}
+
+func TestPostScoreNeedsAFinishedRound(t *testing.T) {
+	g := NewWithSeed("TestPlayer", 2, 1)
+	if _, err := g.PostScore(); err == nil {
+		t.Fatal("expected an error posting a round still in play")
+	}
+
+	for _, hole := range g.Course.Holes {
+		g.ScoreCard.Scores[hole.Number] = hole.Par + 1
+	}
+	g.CurrentHoleIndex = len(g.Course.Holes)
+	posted, err := g.PostScore()
+	if err != nil {
+		t.Fatalf("PostScore returned error: %v", err)
+	}
+	if posted.Gross != g.Course.Par()+2 || len(g.Golfer.ScoringRecord) != 1 {
+		t.Errorf("posted %+v, record %d long", posted, len(g.Golfer.ScoringRecord))
+	}
+}
+
 func TestGameWithCustomRandom(t *testing.T) {
 	rng := rand.New(rand.NewPCG(42, 42))
 	g := NewWithRandom("TestPlayer", 3, rng)
@@ -145,10 +223,10 @@ func TestStrokesThisHole(t *testing.T) {
 		t.Errorf("expected 0 strokes, got %d", g.StrokesThisHole())
 	}
 
-	g.TakeShot(0.5)
+	result := takeShot(t, g, 0.5, g.DefaultAim())
 
-	if g.StrokesThisHole() != 1 {
-		t.Errorf("expected 1 stroke, got %d", g.StrokesThisHole())
+	if g.StrokesThisHole() != 1+result.PenaltyStrokes {
+		t.Errorf("expected 1 stroke plus %d penalty strokes, got %d", result.PenaltyStrokes, g.StrokesThisHole())
 	}
 }
 
@@ -157,7 +235,7 @@ func TestMaxStrokesPerHole(t *testing.T) {
 	g.TeeUp()
 
 	for i := 0; i < 11; i++ {
-		g.TakeShot(0.1)
+		takeShot(t, g, 0.1, g.DefaultAim())
 	}
 
 	if !g.IsHoleComplete() {
@@ -165,6 +243,26 @@ func TestMaxStrokesPerHole(t *testing.T) {
 	}
 }
 
+func TestPickUpMarksTheScoreCard(t *testing.T) {
+	g := New("TestPlayer", 3)
+	g.TeeUp()
+	hole := g.GetCurrentHole()
+	takeShot(t, g, 0.1, g.DefaultAim())
+	strokes := g.StrokesThisHole()
+
+	g.PickUp()
+
+	if !g.IsHoleComplete() || g.HasHoledOut() {
+		t.Fatal("a picked up hole should be over without the ball holed")
+	}
+	if !g.ScoreCard.IsPickedUp(hole) || g.StrokesThisHole() != strokes {
+		t.Errorf("expected the card to mark the pick up and keep the %d strokes taken, got %d", strokes, g.StrokesThisHole())
+	}
+	if reward := g.CompleteHole(); reward != 0 {
+		t.Errorf("a picked up hole should earn nothing, got %d", reward)
+	}
+}
+
 func TestCalculateXP(t *testing.T) {
 	tests := []struct {
 		outcome  gogolf.SkillCheckOutcome
@@ -242,7 +340,7 @@ func TestTakeShotWithShape(t *testing.T) {
 
 	for _, shape := range shapes {
 		g.TeeUp()
-		result := g.TakeShotWithShape(0.8, shape)
+		result := takeShotWithShape(t, g, 0.8, shape, g.DefaultAim())
 
 		if result.IntendedShape != shape {
 			t.Errorf("TakeShotWithShape IntendedShape = %v, want %v", result.IntendedShape, shape)
@@ -266,7 +364,7 @@ func TestTakeShotWithShape_TracksShapeSuccess(t *testing.T) {
 	g := New("TestPlayer", 3)
 	g.TeeUp()
 
-	result := g.TakeShotWithShape(0.8, gogolf.Draw)
+	result := takeShotWithShape(t, g, 0.8, gogolf.Draw, g.DefaultAim())
 
 	if result.IntendedShape != gogolf.Draw {
 		t.Errorf("expected IntendedShape Draw, got %v", result.IntendedShape)
@@ -277,7 +375,7 @@ func TestTakeShotDefaultsToStraight(t *testing.T) {
 	g := New("TestPlayer", 3)
 	g.TeeUp()
 
-	result := g.TakeShot(0.8)
+	result := takeShot(t, g, 0.8, g.DefaultAim())
 
 	if result.IntendedShape != gogolf.Straight {
 		t.Errorf("TakeShot without shape should default to Straight, got %v", result.IntendedShape)
@@ -288,7 +386,7 @@ func TestTakeShotReturnsTargetNumber(t *testing.T) {
 	g := New("TestPlayer", 3)
 	g.TeeUp()
 
-	result := g.TakeShotWithShape(0.8, gogolf.Straight)
+	result := takeShotWithShape(t, g, 0.8, gogolf.Straight, g.DefaultAim())
 
 	if result.TargetNumber == 0 {
 		t.Error("expected TargetNumber to be set in shot result")
@@ -301,14 +399,16 @@ func TestTakeShotWithShoesBonus(t *testing.T) {
 	golfer.EquipShoes(shoes)
 
 	g := NewFromGolfer(golfer, 1)
+	g.Weather = gogolf.CalmWeather()
 	g.TeeUp()
 
-	resultWithShoes := g.TakeShotWithShape(0.8, gogolf.Straight)
+	resultWithShoes := takeShotWithShape(t, g, 0.8, gogolf.Straight, g.DefaultAim())
 
 	g2 := New("TestPlayer2", 1)
+	g2.Weather = gogolf.CalmWeather()
 	g2.TeeUp()
 
-	resultWithoutShoes := g2.TakeShotWithShape(0.8, gogolf.Straight)
+	resultWithoutShoes := takeShotWithShape(t, g2, 0.8, gogolf.Straight, g2.DefaultAim())
 
 	if resultWithShoes.TargetNumber <= resultWithoutShoes.TargetNumber {
 		t.Errorf("Target with shoes (%d) should be > target without shoes (%d)",
@@ -340,19 +440,20 @@ func TestPutterDoesNotApplyShapeModifier(t *testing.T) {
 	golfer.Abilities["Mental"] = gogolf.Ability{Name: "Mental", Level: 5, Experience: 0}
 
 	g := NewFromGolfer(golfer, 1)
+	g.Weather = gogolf.CalmWeather()
 
 	hole := g.GetCurrentHole()
 	g.Ball.Location = hole.HoleLocation
 	g.Ball.Location.X -= 50
+	lie := g.Ball.GetLie(&hole)
 
-	result := g.TakeShotWithShape(0.5, gogolf.Straight)
+	result := takeShotWithShape(t, g, 0.5, gogolf.Straight, g.DefaultAim())
 
 	if result.ClubName != "Putter" {
 		t.Skipf("Expected putter but got %s", result.ClubName)
 	}
 
 	putter := gogolf.Club{Name: "Putter"}
-	lie := g.Ball.GetLie(&hole)
 	expectedTarget := golfer.CalculateTargetNumber(putter, lie.DifficultyModifier())
 
 	if result.TargetNumber != expectedTarget {
@@ -360,3 +461,554 @@ func TestPutterDoesNotApplyShapeModifier(t *testing.T) {
 			result.TargetNumber, expectedTarget)
 	}
 }
+
+func TestSameSeedProducesIdenticalRounds(t *testing.T) {
+	play := func() []ShotResult {
+		g := NewWithSeed("TestPlayer", 3, 1234)
+		var results []ShotResult
+		for !g.IsRoundComplete() {
+			g.TeeUp()
+			for !g.IsHoleComplete() {
+				if relief := g.PendingRelief(); relief != nil {
+					if err := g.TakeRelief(relief.Options[0].Option); err != nil {
+						t.Fatalf("TakeRelief returned error: %v", err)
+					}
+				}
+				results = append(results, takeShotWithShape(t, g, 0.9, gogolf.Draw, g.DefaultAim()))
+			}
+			g.NextHole()
+		}
+		return results
+	}
+
+	first := play()
+	second := play()
+
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("rounds with the same seed diverged:\n%+v\n%+v", first, second)
+	}
+}
+
+func TestDifferentSeedsProduceDifferentRounds(t *testing.T) {
+	g1 := NewWithSeed("TestPlayer", 1, 1)
+	g2 := NewWithSeed("TestPlayer", 1, 2)
+
+	var results1, results2 []ShotResult
+	for i := 0; i < 5; i++ {
+		results1 = append(results1, takeShot(t, g1, 0.9, g1.DefaultAim()))
+		results2 = append(results2, takeShot(t, g2, 0.9, g2.DefaultAim()))
+	}
+
+	if reflect.DeepEqual(results1, results2) {
+		t.Error("expected different seeds to produce different shots")
+	}
+}
+
+func TestNewWithCourseTeesUpAtHoleTee(t *testing.T) {
+	definition := gogolf.CourseDefinition{
+		Version: gogolf.CurrentCourseVersion,
+		Name:    "Custom",
+		Holes: []gogolf.HoleDefinition{{
+			Number: 1,
+			Par:    3,
+			Tee:    gogolf.PointDefinition{X: 20, Y: 10},
+			Pin:    gogolf.PointDefinition{X: 20, Y: 150},
+			Grid:   gogolf.GridDefinition{Width: 40, Length: 160, CellSize: 5},
+		}},
+	}
+	course, err := definition.Build()
+	if err != nil {
+		t.Fatalf("Build returned error: %v", err)
+	}
+
+	g := NewWithCourse(gogolf.NewGolfer("TestPlayer"), course, NewSeededRandom(1))
+	g.TeeUp()
+
+	if g.Ball.Location != course.Holes[0].TeeLocation {
+		t.Errorf("expected ball at tee %+v, got %+v", course.Holes[0].TeeLocation, g.Ball.Location)
+	}
+	if g.ScoreCard.Course.Name != "Custom" {
+		t.Errorf("expected scorecard for course 'Custom', got '%s'", g.ScoreCard.Course.Name)
+	}
+}
+
+func TestTakeShotReportsCarryAndRoll(t *testing.T) {
+	g := NewWithSeed("TestPlayer", 3, 5)
+	g.TeeUp()
+
+	result := takeShot(t, g, 1.0, g.DefaultAim())
+
+	if result.Carry <= 0 {
+		t.Errorf("expected positive carry, got %.1f", result.Carry)
+	}
+	if result.Distance < result.Carry-0.5 {
+		t.Errorf("total %.1f should not be shorter than carry %.1f", result.Distance, result.Carry)
+	}
+}
+
+func TestPuttIsAllRoll(t *testing.T) {
+	g := NewWithSeed("TestPlayer", 1, 5)
+	hole := g.GetCurrentHole()
+	g.Ball.Location = hole.HoleLocation
+	g.Ball.Location.Y -= 40
+	if lie := g.GetContext().Lie; lie != gogolf.Green {
+		t.Fatalf("expected the ball on the green, got %s", lie)
+	}
+
+	result := takeShot(t, g, 0.3, g.DefaultAim())
+
+	if result.ClubName != "Putter" {
+		t.Fatalf("expected the putter, got %s", result.ClubName)
+	}
+	if result.Carry != 0 {
+		t.Errorf("putt carry = %.1f, want 0", result.Carry)
+	}
+	if result.Roll <= 0 {
+		t.Errorf("putt roll = %.1f, want positive", result.Roll)
+	}
+}
+
+// newTestGame builds a one-hole course from hole and starts a round on it from the tee in calm weather
+func newTestGame(t *testing.T, hole gogolf.HoleDefinition, seed uint64) *Game {
+	t.Helper()
+	definition := gogolf.CourseDefinition{
+		Version: gogolf.CurrentCourseVersion,
+		Holes:   []gogolf.HoleDefinition{hole},
+	}
+	course, err := definition.Build()
+	if err != nil {
+		t.Fatalf("Build returned error: %v", err)
+	}
+
+	g := NewWithCourse(gogolf.NewGolfer("TestPlayer"), course, NewSeededRandom(seed))
+	g.Weather = gogolf.CalmWeather()
+	g.TeeUp()
+	return g
+}
+
+func TestTakeShotRecordsObstacleHit(t *testing.T) {
+	g := newTestGame(t, gogolf.HoleDefinition{
+		Number: 1,
+		Par:    4,
+		Tee:    gogolf.PointDefinition{X: 100, Y: 5},
+		Pin:    gogolf.PointDefinition{X: 100, Y: 380},
+		Grid:   gogolf.GridDefinition{Width: 200, Length: 400, CellSize: 10},
+		Obstacles: []gogolf.ObstacleDefinition{{
+			Kind:   "tree_line",
+			Name:   "Wall of pines",
+			Start:  gogolf.PointDefinition{X: 0, Y: 40},
+			End:    &gogolf.PointDefinition{X: 199, Y: 40},
+			Radius: 3,
+			Height: 300,
+		}},
+	}, 3)
+	result := takeShot(t, g, 1.0, g.DefaultAim())
+
+	if result.ObstacleHit == nil {
+		t.Fatal("expected the tee shot to hit the tree line")
+	}
+	if result.ObstacleHit.Obstacle.Name != "Wall of pines" {
+		t.Errorf("expected to hit 'Wall of pines', got '%s'", result.ObstacleHit.Obstacle.Name)
+	}
+	if g.Ball.Location != result.ObstacleHit.Rest {
+		t.Errorf("expected ball to finish where the collision left it, got %+v want %+v",
+			g.Ball.Location, result.ObstacleHit.Rest)
+	}
+}
+
+func TestTakeShotFollowsAim(t *testing.T) {
+	g := NewWithSeed("TestPlayer", 1, 8)
+	g.TeeUp()
+	tee := g.Ball.Location
+	aim := gogolf.NewAim(gogolf.AimFlag, g.GetCurrentHole().HoleLocation).Rotate(tee, 30)
+
+	result := takeShot(t, g, 1.0, aim)
+
+	if g.Golfer.Target != aim.Target {
+		t.Errorf("expected golfer target %+v, got %+v", aim.Target, g.Golfer.Target)
+	}
+	if result.Aim.Target != aim.Target {
+		t.Errorf("expected result to record the aim")
+	}
+	if result.Penalty == gogolf.NoPenalty && g.Ball.Location.X <= tee.X {
+		t.Errorf("shot aimed 30 degrees right finished at %+v, left of the tee %+v", g.Ball.Location, tee)
+	}
+}
+
+func TestTakeShotAtShorterDistance(t *testing.T) {
+	g := NewWithSeed("TestPlayer", 1, 8)
+	g.TeeUp()
+	aim := g.DefaultAim().WithDistance(60)
+
+	result := takeShot(t, g, 1.0, aim)
+
+	if result.Carry > 70 {
+		t.Errorf("full swing at a 60 yard aim carried %.1f yards", result.Carry)
+	}
+}
+
+func TestSelectClubOverridesCaddieForOneShot(t *testing.T) {
+	g := NewWithSeed("TestPlayer", 1, 5)
+	g.TeeUp()
+
+	if err := g.SelectClub("7 Iron"); err != nil {
+		t.Fatalf("SelectClub returned error: %v", err)
+	}
+	if g.GetContext().CurrentClub.Name != "7 Iron" {
+		t.Errorf("expected context club 7 Iron, got %s", g.GetContext().CurrentClub.Name)
+	}
+
+	result := takeShot(t, g, 1.0, g.DefaultAim())
+
+	if result.ClubName != "7 Iron" {
+		t.Errorf("expected shot with 7 Iron, got %s", result.ClubName)
+	}
+	if g.selectedClub != nil {
+		t.Error("selection should not carry over to the next shot")
+	}
+}
+
+func TestSelectClubRejectsUnknownClub(t *testing.T) {
+	g := NewWithSeed("TestPlayer", 1, 5)
+
+	if err := g.SelectClub("Mashie"); err == nil {
+		t.Error("expected error for a club not in the bag")
+	}
+}
+
+func TestCaddieStrategyPicksClub(t *testing.T) {
+	g := NewWithSeed("TestPlayer", 1, 5)
+	g.TeeUp()
+
+	usual := g.CurrentClub()
+	g.Caddie = gogolf.ConservativeSelector{}
+	conservative := g.CurrentClub()
+
+	hole := g.GetCurrentHole()
+	if want := g.Golfer.GetBestClubForLie(hole.TeeLocation.Distance(hole.HoleLocation).Yards(), gogolf.Tee); usual.Name != want.Name {
+		t.Errorf("without a caddie the golfer should make the usual pick %s, got %s", want.Name, usual.Name)
+	}
+	if conservative.Name == "Driver" {
+		t.Error("conservative caddie should not hand over the driver")
+	}
+	if conservative.Distance > usual.Distance {
+		t.Errorf("conservative pick %s should not be longer than the usual pick %s", conservative.Name, usual.Name)
+	}
+}
+
+func TestClubOptionsProjectCarry(t *testing.T) {
+	g := NewWithSeed("TestPlayer", 1, 5)
+
+	options := g.ClubOptions()
+
+	if len(options) != len(g.Golfer.Clubs) {
+		t.Fatalf("expected an option per club, got %d", len(options))
+	}
+	for _, option := range options {
+		if option.Carry > option.Total {
+			t.Errorf("%s carry %.0f exceeds total %.0f", option.Club.Name, option.Carry, option.Total)
+		}
+	}
+}
+
+func TestSameSeedProducesSameWeather(t *testing.T) {
+	g1 := NewWithSeed("TestPlayer", 3, 77)
+	g2 := NewWithSeed("TestPlayer", 3, 77)
+
+	if g1.Weather != g2.Weather {
+		t.Errorf("same seed produced different weather: %+v vs %+v", g1.Weather, g2.Weather)
+	}
+}
+
+func TestTakeShotReportsConditions(t *testing.T) {
+	g := NewWithSeed("TestPlayer", 1, 5)
+	g.Weather = gogolf.Weather{WindSpeed: 10, GustSpeed: 4, Temperature: 60, Rain: gogolf.LightRain}
+	g.TeeUp()
+
+	result := takeShot(t, g, 1.0, g.DefaultAim())
+
+	if result.Conditions.WindSpeed < 10 || result.Conditions.WindSpeed > 14 {
+		t.Errorf("shot wind %.1f should be the round's wind plus any gust", result.Conditions.WindSpeed)
+	}
+	if result.Conditions.Rain != gogolf.LightRain {
+		t.Errorf("expected shot to be played in light rain, got %v", result.Conditions.Rain)
+	}
+}
+
+func newGameOnGreen(t *testing.T, slope gogolf.SlopeDefinition) *Game {
+	t.Helper()
+	return newTestGame(t, gogolf.HoleDefinition{
+		Number:     1,
+		Par:        3,
+		Tee:        gogolf.PointDefinition{X: 20, Y: 5},
+		Pin:        gogolf.PointDefinition{X: 20, Y: 35},
+		Grid:       gogolf.GridDefinition{Width: 40, Length: 40, CellSize: 1},
+		DefaultLie: "green",
+		Green:      &gogolf.GreenDefinition{Slope: slope},
+	}, 4)
+}
+
+func TestPuttBreaksWithTheSlope(t *testing.T) {
+	flat := newGameOnGreen(t, gogolf.SlopeDefinition{})
+	sloped := newGameOnGreen(t, gogolf.SlopeDefinition{X: 3})
+
+	if read := sloped.ReadGreen(); read.Break <= 0 {
+		t.Errorf("expected the putt to read as breaking right, got %+v", read)
+	}
+
+	takeShot(t, flat, 0.5, flat.DefaultAim())
+	takeShot(t, sloped, 0.5, sloped.DefaultAim())
+
+	if sloped.Ball.Location.X <= flat.Ball.Location.X {
+		t.Errorf("putt on a green falling right should finish right of the same putt on a flat green: sloped %+v, flat %+v",
+			sloped.Ball.Location, flat.Ball.Location)
+	}
+}
+
+// newGameOnSlope plays a 150 yard fairway that climbs rise feet from tee to green
+func newGameOnSlope(t *testing.T, rise float64) *Game {
+	t.Helper()
+	return newTestGame(t, gogolf.HoleDefinition{
+		Number:     1,
+		Par:        3,
+		Tee:        gogolf.PointDefinition{X: 20, Y: 5},
+		Pin:        gogolf.PointDefinition{X: 20, Y: 155},
+		Grid:       gogolf.GridDefinition{Width: 40, Length: 170, CellSize: 5},
+		DefaultLie: "fairway",
+		Elevation:  &gogolf.ElevationDefinition{Tee: 0, Green: rise},
+	}, 6)
+}
+
+func TestSuggestClubAllowsForElevation(t *testing.T) {
+	flat := newGameOnSlope(t, 0)
+	uphill := newGameOnSlope(t, 90)
+
+	if got := uphill.GetContext().PlaysLike; got <= flat.GetContext().PlaysLike {
+		t.Errorf("uphill hole should play longer: uphill %v, flat %v", got, flat.GetContext().PlaysLike)
+	}
+	if uphill.CurrentClub().Distance <= flat.CurrentClub().Distance {
+		t.Errorf("expected more club uphill: uphill %s, flat %s", uphill.CurrentClub().Name, flat.CurrentClub().Name)
+	}
+}
+
+func TestUphillShotLandsShort(t *testing.T) {
+	flat := newGameOnSlope(t, 0)
+	uphill := newGameOnSlope(t, 90)
+	flat.SelectClub("7 Iron")
+	uphill.SelectClub("7 Iron")
+
+	flatShot := takeShot(t, flat, 1.0, flat.DefaultAim())
+	uphillShot := takeShot(t, uphill, 1.0, uphill.DefaultAim())
+
+	if uphillShot.Carry >= flatShot.Carry {
+		t.Errorf("rising ground should cut the carry short: uphill %.1f, flat %.1f", uphillShot.Carry, flatShot.Carry)
+	}
+}
+
+func TestSlopingLieRecordsStanceAndCostsTargetNumber(t *testing.T) {
+	flat := newGameOnSlope(t, 0)
+	uphill := newGameOnSlope(t, 90)
+	for _, g := range []*Game{flat, uphill} {
+		g.Ball.TeeUpAt(at(20, 60))
+		g.Golfer.Skills["Short Irons"] = gogolf.Skill{Name: "Short Irons", Level: 6}
+		g.Golfer.Abilities["Touch"] = gogolf.Ability{Name: "Touch", Level: 6}
+		g.SelectClub("8 Iron")
+	}
+
+	flatShot := takeShot(t, flat, 1.0, flat.DefaultAim())
+	uphillShot := takeShot(t, uphill, 1.0, uphill.DefaultAim())
+
+	if uphillShot.Stance.Uphill < 10 {
+		t.Errorf("expected a steep uphill stance, got %+v", uphillShot.Stance)
+	}
+	if uphillShot.TargetNumber != flatShot.TargetNumber-2 {
+		t.Errorf("steep uphill lie should cost 2 from the target number: uphill %d, flat %d", uphillShot.TargetNumber, flatShot.TargetNumber)
+	}
+
+	for _, g := range []*Game{flat, uphill} {
+		g.Ball.TeeUpAt(at(20, 60))
+		g.SelectClub("Putter")
+	}
+	flatPutt := takeShot(t, flat, 0.5, flat.DefaultAim())
+	uphillPutt := takeShot(t, uphill, 0.5, uphill.DefaultAim())
+	if uphillPutt.TargetNumber != flatPutt.TargetNumber {
+		t.Errorf("putts should ignore the stance: uphill %d, flat %d", uphillPutt.TargetNumber, flatPutt.TargetNumber)
+	}
+}
+
+func TestSelectShotType(t *testing.T) {
+	g := newGameOnSlope(t, 0)
+	g.SelectClub("Driver")
+
+	if err := g.SelectShotType(gogolf.Chip); err == nil {
+		t.Error("expected an error chipping with the driver")
+	}
+	if got := g.ShotTypes(); len(got) != 1 || got[0] != gogolf.FullSwing {
+		t.Errorf("driver should only offer a full swing, got %v", got)
+	}
+
+	g.SelectClub("SW")
+	if err := g.SelectShotType(gogolf.Chip); err != nil {
+		t.Fatalf("SelectShotType returned error: %v", err)
+	}
+	if g.CurrentShotType() != gogolf.Chip {
+		t.Errorf("CurrentShotType() = %v, want Chip", g.CurrentShotType())
+	}
+
+	result := takeShot(t, g, 0.5, g.DefaultAim())
+	if result.ShotType != gogolf.Chip {
+		t.Errorf("ShotResult.ShotType = %v, want Chip", result.ShotType)
+	}
+	if g.CurrentShotType() != gogolf.FullSwing {
+		t.Error("the shot type should reset to a full swing after the shot")
+	}
+}
+
+func TestChipRunsFurtherThanItFlies(t *testing.T) {
+	full := newGameOnSlope(t, 0)
+	chip := newGameOnSlope(t, 0)
+	for _, g := range []*Game{full, chip} {
+		g.Golfer.Skills["Wedges"] = gogolf.Skill{Name: "Wedges", Level: 8}
+		g.Golfer.Abilities["Touch"] = gogolf.Ability{Name: "Touch", Level: 8}
+		g.SelectClub("PW")
+	}
+	chip.SelectShotType(gogolf.Chip)
+
+	fullShot := takeShot(t, full, 1.0, full.DefaultAim())
+	chipShot := takeShot(t, chip, 1.0, chip.DefaultAim())
+
+	if chipShot.Distance >= fullShot.Distance/2 {
+		t.Errorf("a chip should travel well short of a full wedge: chip %.1f, full %.1f", chipShot.Distance, fullShot.Distance)
+	}
+	if chipShot.Roll <= chipShot.Carry {
+		t.Errorf("a chip should roll further than it carries: carry %.1f, roll %.1f", chipShot.Carry, chipShot.Roll)
+	}
+}
+
+func TestBunkerSplashEasesTargetNumber(t *testing.T) {
+	newBunkerGame := func() *Game {
+		g := newTestGame(t, gogolf.HoleDefinition{
+			Number:     1,
+			Par:        3,
+			Tee:        gogolf.PointDefinition{X: 20, Y: 5},
+			Pin:        gogolf.PointDefinition{X: 20, Y: 35},
+			Grid:       gogolf.GridDefinition{Width: 40, Length: 40, CellSize: 5},
+			DefaultLie: "bunker",
+		}, 2)
+		g.Golfer.Skills["Wedges"] = gogolf.Skill{Name: "Wedges", Level: 6}
+		g.Golfer.Abilities["Touch"] = gogolf.Ability{Name: "Touch", Level: 6}
+		g.SelectClub("SW")
+		return g
+	}
+	full := newBunkerGame()
+	splash := newBunkerGame()
+	if err := splash.SelectShotType(gogolf.Splash); err != nil {
+		t.Fatalf("SelectShotType returned error: %v", err)
+	}
+
+	fullShot := takeShot(t, full, 0.5, full.DefaultAim())
+	splashShot := takeShot(t, splash, 0.5, splash.DefaultAim())
+
+	if splashShot.TargetNumber != fullShot.TargetNumber+3 {
+		t.Errorf("splash target number = %d, want 3 more than a full swing's %d", splashShot.TargetNumber, fullShot.TargetNumber)
+	}
+}
+
+func TestLowTrajectoryRunsAndHighTrajectoryFlies(t *testing.T) {
+	low := newGameOnSlope(t, 0)
+	high := newGameOnSlope(t, 0)
+	for _, g := range []*Game{low, high} {
+		g.SelectClub("7 Iron")
+	}
+	if err := low.SelectTrajectory(gogolf.LowTrajectory); err != nil {
+		t.Fatalf("SelectTrajectory returned error: %v", err)
+	}
+	high.SelectTrajectory(gogolf.HighTrajectory)
+
+	lowShot := takeShot(t, low, 1.0, low.DefaultAim())
+	highShot := takeShot(t, high, 1.0, high.DefaultAim())
+
+	if lowShot.Trajectory != gogolf.LowTrajectory || highShot.Trajectory != gogolf.HighTrajectory {
+		t.Errorf("ShotResult trajectories = %v and %v, want Low and High", lowShot.Trajectory, highShot.Trajectory)
+	}
+	if lowShot.Apex >= highShot.Apex {
+		t.Errorf("a low ball should peak below a high one: low %.1f ft, high %.1f ft", lowShot.Apex, highShot.Apex)
+	}
+	if lowShot.Roll <= highShot.Roll {
+		t.Errorf("a low ball should run further: low %.1f, high %.1f", lowShot.Roll, highShot.Roll)
+	}
+	if low.CurrentTrajectory() != gogolf.StandardTrajectory {
+		t.Error("the trajectory should reset to standard after the shot")
+	}
+}
+
+func TestBackspinChecksUpOnTheGreen(t *testing.T) {
+	backspin := newGameOnGreen(t, gogolf.SlopeDefinition{})
+	release := newGameOnGreen(t, gogolf.SlopeDefinition{})
+	for _, g := range []*Game{backspin, release} {
+		g.Golfer.Ball = &gogolf.Ball{Name: "Tour", SpinControl: 0.9}
+		g.Golfer.Skills["Wedges"] = gogolf.Skill{Name: "Wedges", Level: 8}
+		g.Golfer.Abilities["Touch"] = gogolf.Ability{Name: "Touch", Level: 8}
+		g.SelectClub("LW")
+	}
+	if err := backspin.SelectSpin(gogolf.Backspin); err != nil {
+		t.Fatalf("SelectSpin returned error: %v", err)
+	}
+	release.SelectSpin(gogolf.Release)
+
+	aim := gogolf.NewAim(gogolf.AimFlag, backspin.GetCurrentHole().HoleLocation)
+	backspinShot := takeShot(t, backspin, 0.3, aim)
+	releaseShot := takeShot(t, release, 0.3, aim)
+
+	if backspinShot.SpinOutcome != gogolf.SpunBack && backspinShot.SpinOutcome != gogolf.CheckedUp {
+		t.Errorf("backspin with a tour ball should check up, got %s", backspinShot.SpinOutcome)
+	}
+	if releaseShot.SpinOutcome != gogolf.Released {
+		t.Errorf("release spin should let the ball run, got %s", releaseShot.SpinOutcome)
+	}
+	if backspinShot.Roll >= releaseShot.Roll {
+		t.Errorf("backspin should stop the ball sooner: backspin %.1f, release %.1f", backspinShot.Roll, releaseShot.Roll)
+	}
+}
+
+func TestPutterIgnoresTrajectoryAndSpin(t *testing.T) {
+	g := newGameOnGreen(t, gogolf.SlopeDefinition{})
+	g.SelectClub("Putter")
+
+	if err := g.SelectTrajectory(gogolf.HighTrajectory); err == nil {
+		t.Error("expected an error flighting a putt high")
+	}
+	if err := g.SelectSpin(gogolf.Backspin); err == nil {
+		t.Error("expected an error putting with backspin")
+	}
+	if result := takeShot(t, g, 1.0, g.DefaultAim()); result.SpinOutcome != gogolf.NoSpinEffect {
+		t.Errorf("a putt should have no spin outcome, got %s", result.SpinOutcome)
+	}
+}
+
+func TestCompleteHolePaysByScoringFormat(t *testing.T) {
+	strokePlay := NewWithSeed("TestPlayer", 3, 42)
+	stableford := NewWithSeed("TestPlayer", 3, 42)
+	stableford.Scoring = gogolf.Stableford{}
+	par := strokePlay.GetCurrentHole().Par
+	for _, g := range []*Game{strokePlay, stableford} {
+		for range par + 1 {
+			g.ScoreCard.RecordStroke(g.GetCurrentHole())
+		}
+		g.lastShotResult = &ShotResult{HoledOut: true}
+	}
+
+	if got := strokePlay.CompleteHole(); got != gogolf.CalculateHoleReward(par, par+1) {
+		t.Errorf("stroke play bogey paid %d, want %d", got, gogolf.CalculateHoleReward(par, par+1))
+	}
+	money := stableford.Golfer.Money
+	if got := stableford.CompleteHole(); got != 5 || stableford.Golfer.Money != money+5 {
+		t.Errorf("a Stableford bogey is one point and should pay 5, paid %d", got)
+	}
+	if got := stableford.Points(); got != 1 {
+		t.Errorf("Points() = %d after a bogey, want 1", got)
+	}
+	if stableford.ScoringFormat().Name() != "Stableford" || strokePlay.ScoringFormat().Name() != "Stroke Play" {
+		t.Error("ScoringFormat should default to stroke play")
+	}
+}
@@ -0,0 +1,373 @@
+package game
+
+import (
+	"fmt"
+	"gogolf"
+	"sort"
+)
+
+// The number of golfers a hot-seat group can hold
+const (
+	MinPlayers = 2
+	MaxPlayers = 4
+)
+
+// TurnReason is why a player is the next to play
+type TurnReason int
+
+const (
+	// Honour is the order golfers tee off in: the lowest score on the previous hole goes first
+	Honour TurnReason = iota
+	// Away is the golfer whose ball lies furthest from the hole once everyone has teed off
+	Away
+)
+
+func (r TurnReason) String() string {
+	return [...]string{
+		"honour",
+		"away",
+	}[r]
+}
+
+// Group is a hot-seat round: golfers take turns on the same course in the same weather,
+// each playing their own ball and keeping their own scorecard
+type Group struct {
+	Players []*Game
+	// Match scores the round hole by hole when the two golfers are playing match play; nil for stroke play
+	Match *gogolf.Match
+	// order lists player indices in honour order, the golfer with the honour first
+	order []int
+	// concededBy is the player who conceded the current hole, or -1
+	concededBy int
+}
+
+// Standing is one golfer's place on the leaderboard
+type Standing struct {
+	Player     int
+	Name       string
+	Strokes    int
+	ScoreToPar int
+	// Thru is how many holes the golfer has finished
+	Thru int
+	// PickedUp counts the finished holes the golfer abandoned without holing out; Strokes holds
+	// only the strokes taken on them, so the golfer has no true stroke-play score
+	PickedUp int
+	// Points is the golfer's result in the group's scoring format over the holes everyone has finished
+	Points int
+}
+
+// NewGroup creates a round for the golfers over course. Every random draw comes from rng,
+// and the golfers tee off in the order given until someone wins a hole.
+func NewGroup(golfers []gogolf.Golfer, course gogolf.Course, rng gogolf.RandomSource) (*Group, error) {
+	if len(golfers) < MinPlayers || len(golfers) > MaxPlayers {
+		return nil, fmt.Errorf("a group needs %d to %d players, got %d", MinPlayers, MaxPlayers, len(golfers))
+	}
+	group := &Group{concededBy: -1}
+	for i, golfer := range golfers {
+		g := NewWithCourse(golfer, course, rng)
+		if i > 0 {
+			g.Weather = group.Players[0].Weather
+		}
+		group.Players = append(group.Players, g)
+		group.order = append(group.order, i)
+	}
+	return group, nil
+}
+
+// NewGroupWithSeed generates the course and plays the round from the same seed
+func NewGroupWithSeed(golfers []gogolf.Golfer, holeCount int, seed uint64) (*Group, error) {
+	return NewGroup(golfers, gogolf.GenerateCourse(holeCount, seed), NewSeededRandom(seed))
+}
+
+// NewMatchPlay creates a match play round between two golfers over course
+func NewMatchPlay(golfers []gogolf.Golfer, course gogolf.Course, rng gogolf.RandomSource) (*Group, error) {
+	if len(golfers) != 2 {
+		return nil, fmt.Errorf("match play needs 2 players, got %d", len(golfers))
+	}
+	group, err := NewGroup(golfers, course, rng)
+	if err != nil {
+		return nil, err
+	}
+	match := gogolf.NewMatch(golfers[0].Name, golfers[1].Name, len(course.Holes))
+	group.Match = &match
+	return group, nil
+}
+
+// NewMatchPlayWithSeed generates the course and plays the match from the same seed
+func NewMatchPlayWithSeed(golfers []gogolf.Golfer, holeCount int, seed uint64) (*Group, error) {
+	return NewMatchPlay(golfers, gogolf.GenerateCourse(holeCount, seed), NewSeededRandom(seed))
+}
+
+// TeeUp puts every golfer's ball on the current hole's tee
+func (gr *Group) TeeUp() {
+	gr.concededBy = -1
+	for _, g := range gr.Players {
+		g.TeeUp()
+	}
+}
+
+// SetScoring scores every golfer's round in format
+func (gr *Group) SetScoring(format gogolf.ScoringFormat) {
+	for _, g := range gr.Players {
+		g.Scoring = format
+	}
+}
+
+// ScoringFormat is the format the group is scored in
+func (gr *Group) ScoringFormat() gogolf.ScoringFormat {
+	return gr.Players[0].ScoringFormat()
+}
+
+// cards returns each golfer's scorecard in player order
+func (gr *Group) cards() []gogolf.ScoreCard {
+	cards := make([]gogolf.ScoreCard, len(gr.Players))
+	for i, g := range gr.Players {
+		cards[i] = g.ScoreCard
+	}
+	return cards
+}
+
+// ConcedePutt gives player their next putt, counting the stroke and finishing their hole.
+// Putts can only be conceded in match play.
+func (gr *Group) ConcedePutt(player int) error {
+	if gr.Match == nil {
+		return fmt.Errorf("putts can only be conceded in match play")
+	}
+	if player < 0 || player >= len(gr.Players) {
+		return fmt.Errorf("invalid player %d", player)
+	}
+	return gr.Players[player].ConcedePutt()
+}
+
+// ConcedeHole lets player give the current hole to their opponent; both golfers pick up
+func (gr *Group) ConcedeHole(player int) error {
+	if gr.Match == nil {
+		return fmt.Errorf("holes can only be conceded in match play")
+	}
+	if player < 0 || player >= len(gr.Players) {
+		return fmt.Errorf("invalid player %d", player)
+	}
+	if gr.IsHoleComplete() {
+		return fmt.Errorf("hole %d is already finished", gr.Players[player].GetCurrentHole().Number)
+	}
+	gr.concededBy = player
+	for _, g := range gr.Players {
+		g.PickUp()
+	}
+	return nil
+}
+
+// HonourOrder lists player indices in the order they tee off, the golfer with the honour first
+func (gr *Group) HonourOrder() []int {
+	return append([]int(nil), gr.order...)
+}
+
+// NextToPlay returns the index of the golfer who plays next and why. Everyone tees off in
+// honour order; after that the golfer furthest from the hole plays, with ties going to the
+// golfer with the better honour. It returns -1 once every golfer has finished the hole.
+func (gr *Group) NextToPlay() (int, TurnReason) {
+	if gr.IsHoleComplete() {
+		return -1, Away
+	}
+	for _, i := range gr.order {
+		g := gr.Players[i]
+		if !g.IsHoleComplete() && g.StrokesThisHole() == 0 {
+			return i, Honour
+		}
+	}
+
+	away := -1
+	furthest := gogolf.Unit(-1)
+	for _, i := range gr.order {
+		g := gr.Players[i]
+		if g.IsHoleComplete() {
+			continue
+		}
+		if distance := g.Ball.Location.Distance(g.GetCurrentHole().HoleLocation); distance > furthest {
+			away, furthest = i, distance
+		}
+	}
+	return away, Away
+}
+
+// IsHoleComplete reports whether every golfer has finished the current hole.
+// In match play the hole also ends once it is conceded or one golfer has holed out
+// in fewer strokes than the other can still manage.
+func (gr *Group) IsHoleComplete() bool {
+	if gr.Match != nil && (gr.concededBy >= 0 || gr.holeDecided()) {
+		return true
+	}
+	for _, g := range gr.Players {
+		if !g.IsHoleComplete() {
+			return false
+		}
+	}
+	return true
+}
+
+// holeDecided reports whether one golfer in a match has holed out and the other
+// has already taken as many strokes without doing so
+func (gr *Group) holeDecided() bool {
+	for i, g := range gr.Players {
+		opponent := gr.Players[1-i]
+		if g.HasHoledOut() && !opponent.IsHoleComplete() && opponent.StrokesThisHole() >= g.StrokesThisHole() {
+			return true
+		}
+	}
+	return false
+}
+
+// IsRoundComplete reports whether every hole has been played, or in match play whether the match is decided
+func (gr *Group) IsRoundComplete() bool {
+	if gr.Match != nil && gr.Match.IsOver() {
+		return true
+	}
+	return gr.Players[0].IsRoundComplete()
+}
+
+// CompleteHole pays each golfer for the hole as the scoring format rewards it, comparing
+// cards where the format needs to, and passes the honour to the lowest score. Golfers who tie
+// keep the order they teed off in. In match play the hole is scored in the match and a golfer
+// who has not holed out picks up; once the match is decided no more holes can be completed.
+// It returns each player's reward.
+func (gr *Group) CompleteHole() ([]int, error) {
+	if gr.Match != nil && gr.Match.IsOver() {
+		return nil, fmt.Errorf("the match is over: %s", gr.Match.Result())
+	}
+	strokes := make([]int, len(gr.Players))
+	for i, g := range gr.Players {
+		strokes[i] = g.StrokesThisHole()
+		if gr.Match != nil && !g.HasHoledOut() {
+			g.PickUp()
+		}
+	}
+	rewards := gr.ScoringFormat().HoleRewards(gr.cards(), gr.Players[0].GetCurrentHole())
+	for i, g := range gr.Players {
+		rewards[i] = g.payHoleReward(rewards[i])
+	}
+	if gr.Match != nil {
+		if err := gr.scoreMatchHole(strokes); err != nil {
+			return nil, err
+		}
+		return rewards, nil
+	}
+	sort.SliceStable(gr.order, func(a, b int) bool {
+		return strokes[gr.order[a]] < strokes[gr.order[b]]
+	})
+	return rewards, nil
+}
+
+// scoreMatchHole records the hole in the match. A golfer who picked up without holing out
+// loses to one who holed out; the winner takes the honour and a half leaves it where it was.
+func (gr *Group) scoreMatchHole(strokes []int) error {
+	var winner int
+	if gr.concededBy >= 0 {
+		winner = 1 - gr.concededBy
+		if err := gr.Match.ConcedeHole(gr.concededBy); err != nil {
+			return err
+		}
+	} else {
+		first, second := strokes[0], strokes[1]
+		if !gr.Players[0].HasHoledOut() && gr.Players[1].HasHoledOut() {
+			first = max(first, second+1)
+		}
+		if !gr.Players[1].HasHoledOut() && gr.Players[0].HasHoledOut() {
+			second = max(second, first+1)
+		}
+		var err error
+		if winner, err = gr.Match.RecordHole(first, second); err != nil {
+			return err
+		}
+	}
+	if winner != gogolf.HalvedHole {
+		gr.order = []int{winner, 1 - winner}
+	}
+	return nil
+}
+
+// NextHole moves every golfer on to the next hole
+func (gr *Group) NextHole() {
+	for _, g := range gr.Players {
+		g.NextHole()
+	}
+}
+
+// Leaderboard ranks the golfers by the scoring format, or by score to par in stroke play,
+// over the holes they have finished. In stroke play a golfer who picked up a hole has no
+// score and ranks below every golfer who holed out.
+func (gr *Group) Leaderboard() []Standing {
+	format := gr.ScoringFormat()
+	var points []int
+	if everyone := gr.holesEveryoneFinished(); everyone > 0 {
+		points = format.Score(gr.cards(), gr.Players[0].Course.Holes[everyone-1].Number)
+	}
+
+	standings := make([]Standing, 0, len(gr.Players))
+	for _, i := range gr.order {
+		g := gr.Players[i]
+		standing := Standing{Player: i, Name: g.Golfer.Name, Thru: g.holesFinished()}
+		if standing.Thru > 0 {
+			last := g.Course.Holes[standing.Thru-1].Number
+			standing.Strokes = g.ScoreCard.TotalStrokesThrough(last)
+			standing.ScoreToPar = g.ScoreCard.ScoreThrough(last)
+			standing.PickedUp = g.ScoreCard.PickUpsThrough(last)
+		}
+		if points != nil {
+			standing.Points = points[i]
+		}
+		standings = append(standings, standing)
+	}
+	sort.SliceStable(standings, func(a, b int) bool {
+		if _, strokePlay := format.(gogolf.StrokePlay); strokePlay {
+			if (standings[a].PickedUp > 0) != (standings[b].PickedUp > 0) {
+				return standings[b].PickedUp > 0
+			}
+			return standings[a].ScoreToPar < standings[b].ScoreToPar
+		}
+		if format.LowerIsBetter() {
+			return standings[a].Points < standings[b].Points
+		}
+		return standings[a].Points > standings[b].Points
+	})
+	return standings
+}
+
+// holesEveryoneFinished is the number of holes every golfer in the group has finished
+func (gr *Group) holesEveryoneFinished() int {
+	finished := len(gr.Players[0].Course.Holes)
+	for _, g := range gr.Players {
+		finished = min(finished, g.holesFinished())
+	}
+	return finished
+}
+
+// holesFinished counts the holes the golfer has played out, including the current one once it is complete
+func (g *Game) holesFinished() int {
+	if g.IsRoundComplete() {
+		return len(g.Course.Holes)
+	}
+	if g.IsHoleComplete() {
+		return g.CurrentHoleIndex + 1
+	}
+	return g.CurrentHoleIndex
+}
+
+// SavePlayers saves each golfer's progression to their own slot, slots[i] holding Players[i]
+func (gr *Group) SavePlayers(saveManager *gogolf.SaveManager, slots []int) error {
+	if len(slots) != len(gr.Players) {
+		return fmt.Errorf("need a save slot for each of the %d players, got %d", len(gr.Players), len(slots))
+	}
+	for i, slot := range slots {
+		for _, other := range slots[:i] {
+			if other == slot {
+				return fmt.Errorf("save slot %d is used by more than one player", slot)
+			}
+		}
+	}
+	for i, g := range gr.Players {
+		if err := saveManager.Save(slots[i], g.Golfer); err != nil {
+			return fmt.Errorf("failed to save %s: %w", g.Golfer.Name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,314 @@
+package game
+
+import (
+	"gogolf"
+	"testing"
+)
+
+func newTestGroup(t *testing.T, names ...string) *Group {
+	t.Helper()
+	golfers := make([]gogolf.Golfer, len(names))
+	for i, name := range names {
+		golfers[i] = gogolf.NewGolfer(name)
+	}
+	group, err := NewGroupWithSeed(golfers, 3, 11)
+	if err != nil {
+		t.Fatalf("NewGroupWithSeed returned error: %v", err)
+	}
+	return group
+}
+
+// completeHole finishes the group's hole, failing the test if the group refuses
+func completeHole(t *testing.T, group *Group) []int {
+	t.Helper()
+	rewards, err := group.CompleteHole()
+	if err != nil {
+		t.Fatalf("CompleteHole returned error: %v", err)
+	}
+	return rewards
+}
+
+func TestNewGroupRejectsGroupSize(t *testing.T) {
+	course := gogolf.GenerateCourse(3, 1)
+	for _, count := range []int{1, 5} {
+		golfers := make([]gogolf.Golfer, count)
+		if _, err := NewGroup(golfers, course, NewSeededRandom(1)); err == nil {
+			t.Errorf("expected an error for a group of %d", count)
+		}
+	}
+}
+
+func TestGroupPlayersShareCourseAndWeatherButNotBalls(t *testing.T) {
+	group := newTestGroup(t, "Alice", "Bob", "Cara")
+	alice, bob := group.Players[0], group.Players[1]
+
+	if alice.Course.Name != bob.Course.Name || alice.Weather != bob.Weather {
+		t.Error("players should share the course and the weather")
+	}
+
+	takeShot(t, alice, 1.0, alice.DefaultAim())
+	if bob.Ball.Location != bob.GetCurrentHole().TeeLocation {
+		t.Error("one player's shot should not move another's ball")
+	}
+	if bob.ScoreCard.TotalStrokes() != 0 || alice.ScoreCard.TotalStrokes() == 0 {
+		t.Errorf("each player should keep their own card: alice %d, bob %d", alice.ScoreCard.TotalStrokes(), bob.ScoreCard.TotalStrokes())
+	}
+}
+
+func TestNextToPlayTeesOffInHonourOrderThenAway(t *testing.T) {
+	group := newTestGroup(t, "Alice", "Bob", "Cara")
+
+	for want := range group.Players {
+		i, reason := group.NextToPlay()
+		if i != want || reason != Honour {
+			t.Fatalf("tee shot %d: NextToPlay() = %d (%s), want %d (honour)", want, i, reason, want)
+		}
+		g := group.Players[i]
+		hole := g.GetCurrentHole()
+		g.ScoreCard.RecordStroke(hole)
+		g.Ball.Location = hole.HoleLocation.Move(hole.TeeLocation.Direction(hole.HoleLocation), -float64(gogolf.Yard(50*(i+1)).Units()))
+	}
+
+	if i, reason := group.NextToPlay(); i != 2 || reason != Away {
+		t.Errorf("NextToPlay() = %d (%s), want the furthest ball, 2 (away)", i, reason)
+	}
+}
+
+func TestCompleteHolePassesTheHonour(t *testing.T) {
+	group := newTestGroup(t, "Alice", "Bob", "Cara")
+	for i, strokes := range []int{5, 3, 5} {
+		g := group.Players[i]
+		for range strokes {
+			g.ScoreCard.RecordStroke(g.GetCurrentHole())
+		}
+	}
+
+	completeHole(t, group)
+
+	order := group.HonourOrder()
+	if order[0] != 1 || order[1] != 0 || order[2] != 2 {
+		t.Errorf("HonourOrder() = %v, want [1 0 2]: lowest score first, ties keep their order", order)
+	}
+}
+
+func TestGroupPlaysAHoleOut(t *testing.T) {
+	group := newTestGroup(t, "Alice", "Bob")
+	group.TeeUp()
+
+	for shots := 0; !group.IsHoleComplete(); shots++ {
+		if shots > 30 {
+			t.Fatal("the hole never finished")
+		}
+		i, _ := group.NextToPlay()
+		g := group.Players[i]
+		takeShot(t, g, 1.0, g.DefaultAim())
+		if relief := g.PendingRelief(); relief != nil {
+			g.TakeRelief(relief.Options[0].Option)
+		}
+	}
+	if i, _ := group.NextToPlay(); i != -1 {
+		t.Errorf("NextToPlay() = %d after the hole, want -1", i)
+	}
+
+	completeHole(t, group)
+	standings := group.Leaderboard()
+	if len(standings) != 2 || standings[0].Thru != 1 {
+		t.Fatalf("Leaderboard() = %+v, want both players through 1", standings)
+	}
+	if standings[0].ScoreToPar > standings[1].ScoreToPar {
+		t.Errorf("leaderboard out of order: %+v", standings)
+	}
+	for _, standing := range standings {
+		g := group.Players[standing.Player]
+		if standing.Strokes != g.StrokesThisHole() {
+			t.Errorf("%s strokes = %d, want %d", standing.Name, standing.Strokes, g.StrokesThisHole())
+		}
+	}
+}
+
+func TestSavePlayersUsesASlotEach(t *testing.T) {
+	group := newTestGroup(t, "Alice", "Bob")
+	group.Players[1].Golfer.Money = 250
+	saveManager := gogolf.NewSaveManager(t.TempDir())
+
+	if err := group.SavePlayers(saveManager, []int{2, 2}); err == nil {
+		t.Error("expected an error saving two players to one slot")
+	}
+	if err := group.SavePlayers(saveManager, []int{1}); err == nil {
+		t.Error("expected an error when a player has no slot")
+	}
+	if err := group.SavePlayers(saveManager, []int{1, 3}); err != nil {
+		t.Fatalf("SavePlayers returned error: %v", err)
+	}
+
+	bob, err := saveManager.Load(3)
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if bob.Name != "Bob" || bob.Money != 250 {
+		t.Errorf("slot 3 = %s with %d money, want Bob with 250", bob.Name, bob.Money)
+	}
+}
+
+func newTestMatch(t *testing.T) *Group {
+	t.Helper()
+	group, err := NewMatchPlayWithSeed([]gogolf.Golfer{gogolf.NewGolfer("Alice"), gogolf.NewGolfer("Bob")}, 3, 11)
+	if err != nil {
+		t.Fatalf("NewMatchPlayWithSeed returned error: %v", err)
+	}
+	group.TeeUp()
+	return group
+}
+
+func TestMatchPlayNeedsTwoPlayers(t *testing.T) {
+	golfers := []gogolf.Golfer{gogolf.NewGolfer("A"), gogolf.NewGolfer("B"), gogolf.NewGolfer("C")}
+	if _, err := NewMatchPlayWithSeed(golfers, 3, 1); err == nil {
+		t.Error("expected an error for a three-player match")
+	}
+}
+
+func TestConcessionsNeedMatchPlay(t *testing.T) {
+	group := newTestGroup(t, "Alice", "Bob")
+
+	if err := group.ConcedePutt(0); err == nil {
+		t.Error("expected an error conceding a putt in stroke play")
+	}
+	if err := group.ConcedeHole(0); err == nil {
+		t.Error("expected an error conceding a hole in stroke play")
+	}
+}
+
+func TestConcededPuttWinsTheHole(t *testing.T) {
+	group := newTestMatch(t)
+	alice, bob := group.Players[0], group.Players[1]
+	for range 3 {
+		alice.ScoreCard.RecordStroke(alice.GetCurrentHole())
+		bob.ScoreCard.RecordStroke(bob.GetCurrentHole())
+	}
+	bob.ScoreCard.RecordStroke(bob.GetCurrentHole())
+
+	if err := group.ConcedePutt(0); err != nil {
+		t.Fatalf("ConcedePutt returned error: %v", err)
+	}
+	if alice.StrokesThisHole() != 4 || !alice.HasHoledOut() {
+		t.Errorf("a conceded putt should count as holed in 4, got %d strokes", alice.StrokesThisHole())
+	}
+	if !group.IsHoleComplete() {
+		t.Fatal("Bob has already taken 4 without holing out, so the hole should be over")
+	}
+
+	completeHole(t, group)
+	if group.Match.Lead() != 1 {
+		t.Errorf("Match.Lead() = %d, want Alice 1 up", group.Match.Lead())
+	}
+	if order := group.HonourOrder(); order[0] != 0 {
+		t.Errorf("the hole's winner should have the honour, got %v", order)
+	}
+}
+
+func TestConcededHoleGoesToTheOpponent(t *testing.T) {
+	group := newTestMatch(t)
+
+	if err := group.ConcedeHole(0); err != nil {
+		t.Fatalf("ConcedeHole returned error: %v", err)
+	}
+	if !group.IsHoleComplete() {
+		t.Fatal("a conceded hole should be over")
+	}
+	if i, _ := group.NextToPlay(); i != -1 {
+		t.Errorf("NextToPlay() = %d on a conceded hole, want -1", i)
+	}
+	if err := group.ConcedeHole(1); err == nil {
+		t.Error("expected an error conceding a hole that is already over")
+	}
+
+	hole := group.Players[0].GetCurrentHole()
+	rewards := completeHole(t, group)
+	if rewards[0] != 0 || rewards[1] != 0 {
+		t.Errorf("nobody holed out, so nobody should be paid, got %v", rewards)
+	}
+	for _, g := range group.Players {
+		if !g.ScoreCard.IsPickedUp(hole) {
+			t.Errorf("%s should be marked as having picked up on the scorecard", g.Golfer.Name)
+		}
+	}
+	if got := group.Match.Status(); got != "Bob 1 UP with 2 to play" {
+		t.Errorf("Match.Status() = %q", got)
+	}
+	if order := group.HonourOrder(); order[0] != 1 {
+		t.Errorf("Bob should have the honour, got %v", order)
+	}
+}
+
+func TestMatchEndsEarly(t *testing.T) {
+	group := newTestMatch(t)
+
+	for range 2 {
+		if err := group.ConcedeHole(1); err != nil {
+			t.Fatalf("ConcedeHole returned error: %v", err)
+		}
+		completeHole(t, group)
+		group.NextHole()
+		group.TeeUp()
+	}
+
+	if !group.IsRoundComplete() {
+		t.Fatal("two up with one to play should end the match")
+	}
+	if got := group.Match.Result(); got != "Alice wins 2 & 1" {
+		t.Errorf("Match.Result() = %q, want %q", got, "Alice wins 2 & 1")
+	}
+	if _, err := group.CompleteHole(); err == nil {
+		t.Error("expected an error completing a hole after the match is over")
+	}
+	if group.Match.HolesPlayed() != 2 {
+		t.Errorf("Match.HolesPlayed() = %d, want the 2 holes played before it ended", group.Match.HolesPlayed())
+	}
+}
+
+func TestLeaderboardRanksPickUpsLast(t *testing.T) {
+	group := newTestGroup(t, "Alice", "Bob")
+	group.TeeUp()
+	alice, bob := group.Players[0], group.Players[1]
+	for range 8 {
+		alice.ScoreCard.RecordStroke(alice.GetCurrentHole())
+	}
+	alice.lastShotResult = &ShotResult{HoledOut: true}
+	bob.ScoreCard.RecordStroke(bob.GetCurrentHole())
+	bob.PickUp()
+
+	completeHole(t, group)
+	standings := group.Leaderboard()
+
+	if standings[0].Name != "Alice" || standings[1].Name != "Bob" {
+		t.Fatalf("Leaderboard() = %+v, want Alice who holed out ahead of Bob who picked up", standings)
+	}
+	if standings[1].PickedUp != 1 || standings[1].Strokes != 1 {
+		t.Errorf("Bob's standing = %+v, want 1 stroke and 1 hole picked up", standings[1])
+	}
+}
+
+func TestSkinsLeaderboardRanksBySkins(t *testing.T) {
+	group := newTestGroup(t, "Alice", "Bob")
+	group.SetScoring(gogolf.Skins{})
+	alice, bob := group.Players[0], group.Players[1]
+
+	for hole, strokes := range [][2]int{{4, 4}, {3, 5}} {
+		for i, g := range []*Game{alice, bob} {
+			for range strokes[i] {
+				g.ScoreCard.RecordStroke(g.GetCurrentHole())
+			}
+			g.lastShotResult = &ShotResult{HoledOut: true}
+		}
+		rewards := completeHole(t, group)
+		if hole == 1 && rewards[0] <= rewards[1] {
+			t.Errorf("Alice won two skins on the second hole and should be paid more, got %v", rewards)
+		}
+		group.NextHole()
+	}
+
+	standings := group.Leaderboard()
+	if standings[0].Name != "Alice" || standings[0].Points != 2 || standings[1].Points != 0 {
+		t.Errorf("Leaderboard() = %+v, want Alice first with both skins", standings)
+	}
+}
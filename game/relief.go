@@ -0,0 +1,95 @@
+package game
+
+import (
+	"fmt"
+	"gogolf"
+)
+
+// Relief describes the ways to continue after a ball comes to rest in a penalty area
+type Relief struct {
+	EntryPoint gogolf.Point
+	Options    []ReliefChoice
+}
+
+// ReliefChoice is a relief option together with where the ball would be dropped
+type ReliefChoice struct {
+	Option         gogolf.ReliefOption
+	Drop           gogolf.Point
+	Lie            gogolf.LieType
+	DistanceToHole gogolf.Yard
+}
+
+// PendingRelief returns the relief the golfer must take before playing on, or nil if there is none
+func (g *Game) PendingRelief() *Relief {
+	return g.pendingRelief
+}
+
+// TakeRelief adds the penalty stroke and drops the ball for the chosen option
+func (g *Game) TakeRelief(option gogolf.ReliefOption) error {
+	if g.pendingRelief == nil {
+		return fmt.Errorf("no relief to take: ball is not in a penalty area")
+	}
+
+	for _, choice := range g.pendingRelief.Options {
+		if choice.Option != option {
+			continue
+		}
+		hole := g.GetCurrentHole()
+		g.ScoreCard.RecordPenalty(hole)
+		g.Ball.Location = choice.Drop
+		g.pendingRelief = nil
+		g.selectedClub = nil
+		g.resetShotSetup()
+		if g.lastShotResult != nil {
+			g.lastShotResult.PenaltyStrokes++
+		}
+		if shot := g.ScoreCard.LastShot(hole); shot != nil {
+			shot.Penalties++
+		}
+		return nil
+	}
+	return fmt.Errorf("relief option %v is not available", option)
+}
+
+// applyPenalties enforces the rules once a shot has come to rest. A ball out of bounds or lost
+// is replayed under stroke and distance; one in a penalty area waits for the golfer to choose relief.
+func (g *Game) applyPenalties(h gogolf.Hole, flight gogolf.Flight) gogolf.PenaltyKind {
+	lie := h.GetLieAtPosition(flight.Rest)
+
+	switch {
+	case h.IsOutOfBounds(flight.Rest):
+		g.strokeAndDistance(h, flight)
+		return gogolf.OutOfBounds
+	case lie == gogolf.PenaltyArea:
+		g.pendingRelief = reliefFor(h, flight)
+		return gogolf.InPenaltyArea
+	case !gogolf.SearchForBall(lie, g.random):
+		g.strokeAndDistance(h, flight)
+		return gogolf.LostBall
+	}
+	return gogolf.NoPenalty
+}
+
+func (g *Game) strokeAndDistance(h gogolf.Hole, flight gogolf.Flight) {
+	g.ScoreCard.RecordPenalty(h)
+	g.Ball.Location = flight.Origin
+}
+
+func reliefFor(h gogolf.Hole, flight gogolf.Flight) *Relief {
+	entry := h.PenaltyAreaEntry(flight.Origin, flight.Rest)
+	relief := &Relief{EntryPoint: entry}
+
+	for _, option := range []gogolf.ReliefOption{gogolf.BackOnTheLine, gogolf.LateralRelief, gogolf.StrokeAndDistance} {
+		drop, ok := h.ReliefPoint(option, entry, flight.Origin)
+		if !ok {
+			continue
+		}
+		relief.Options = append(relief.Options, ReliefChoice{
+			Option:         option,
+			Drop:           drop,
+			Lie:            h.GetLieAtPosition(drop),
+			DistanceToHole: drop.Distance(h.HoleLocation).Yards(),
+		})
+	}
+	return relief
+}
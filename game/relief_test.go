@@ -0,0 +1,141 @@
+package game
+
+import (
+	"gogolf"
+	"testing"
+)
+
+// newGameWithCreek plays a 300 yard hole with a creek crossing the fairway between 150 and 170 yards
+func newGameWithCreek(t *testing.T) *Game {
+	t.Helper()
+	return newTestGame(t, gogolf.HoleDefinition{
+		Number: 1,
+		Par:    4,
+		Tee:    gogolf.PointDefinition{X: 40, Y: 5},
+		Pin:    gogolf.PointDefinition{X: 40, Y: 290},
+		Grid:   gogolf.GridDefinition{Width: 80, Length: 300, CellSize: 5},
+		Regions: []gogolf.RegionDefinition{{
+			Lie:   "penalty_area",
+			Shape: gogolf.RegionRectangle,
+			Min:   &gogolf.PointDefinition{X: 0, Y: 150},
+			Max:   &gogolf.PointDefinition{X: 80, Y: 170},
+		}},
+	}, 1)
+}
+
+func at(x, y gogolf.Yard) gogolf.Point {
+	return gogolf.Point{X: int(x.Units()), Y: int(y.Units())}
+}
+
+func TestOutOfBoundsIsStrokeAndDistance(t *testing.T) {
+	g := newGameWithCreek(t)
+	hole := g.GetCurrentHole()
+	tee := g.Ball.Location
+	g.ScoreCard.RecordStroke(hole)
+
+	penalty := g.applyPenalties(hole, gogolf.Flight{Origin: tee, Landing: at(95, 200), Rest: at(95, 210)})
+
+	if penalty != gogolf.OutOfBounds {
+		t.Fatalf("expected out of bounds, got %v", penalty)
+	}
+	if g.Ball.Location != tee {
+		t.Errorf("expected ball back at %+v, got %+v", tee, g.Ball.Location)
+	}
+	if g.StrokesThisHole() != 2 {
+		t.Errorf("expected stroke plus penalty = 2, got %d", g.StrokesThisHole())
+	}
+	if g.PendingRelief() != nil {
+		t.Error("out of bounds should not offer relief options")
+	}
+}
+
+func TestPenaltyAreaOffersRelief(t *testing.T) {
+	g := newGameWithCreek(t)
+	hole := g.GetCurrentHole()
+	tee := g.Ball.Location
+	g.ScoreCard.RecordStroke(hole)
+
+	penalty := g.applyPenalties(hole, gogolf.Flight{Origin: tee, Landing: at(40, 140), Rest: at(40, 160)})
+
+	if penalty != gogolf.InPenaltyArea {
+		t.Fatalf("expected penalty area, got %v", penalty)
+	}
+	relief := g.PendingRelief()
+	if relief == nil {
+		t.Fatal("expected relief to be pending")
+	}
+	if len(relief.Options) != 3 {
+		t.Errorf("expected back-on-the-line, lateral and replay options, got %+v", relief.Options)
+	}
+	if g.IsHoleComplete() {
+		t.Error("hole should not be complete while relief is pending")
+	}
+	if g.StrokesThisHole() != 1 {
+		t.Errorf("penalty stroke should wait until relief is taken, got %d strokes", g.StrokesThisHole())
+	}
+}
+
+func TestTakeRelief(t *testing.T) {
+	g := newGameWithCreek(t)
+	hole := g.GetCurrentHole()
+	g.ScoreCard.RecordStroke(hole)
+	g.applyPenalties(hole, gogolf.Flight{Origin: g.Ball.Location, Landing: at(40, 140), Rest: at(40, 160)})
+
+	if err := g.TakeRelief(gogolf.BackOnTheLine); err != nil {
+		t.Fatalf("TakeRelief returned error: %v", err)
+	}
+
+	if g.PendingRelief() != nil {
+		t.Error("expected relief to be cleared")
+	}
+	if lie := g.Ball.GetLie(&hole); lie == gogolf.PenaltyArea {
+		t.Errorf("expected the ball dropped out of the penalty area, got %v", lie)
+	}
+	if g.Ball.Location.Y >= at(40, 150).Y {
+		t.Errorf("back-on-the-line drop %+v should be behind the creek", g.Ball.Location)
+	}
+	if g.StrokesThisHole() != 2 || g.ScoreCard.PenaltiesThisHole(hole) != 1 {
+		t.Errorf("expected 2 strokes including 1 penalty, got %d strokes", g.StrokesThisHole())
+	}
+}
+
+func TestTakeReliefChargesTheShot(t *testing.T) {
+	g := newGameWithCreek(t)
+	hole := g.GetCurrentHole()
+	g.ScoreCard.RecordStroke(hole)
+	g.ScoreCard.AddShot(hole, gogolf.ShotRecord{Club: "3 Wood", StartLie: gogolf.Tee, EndLie: gogolf.PenaltyArea})
+	g.applyPenalties(hole, gogolf.Flight{Origin: g.Ball.Location, Landing: at(40, 140), Rest: at(40, 160)})
+
+	g.TakeRelief(gogolf.LateralRelief)
+
+	if shot := g.ScoreCard.LastShot(hole); shot.Penalties != 1 {
+		t.Errorf("expected the penalty charged to the shot into the creek, got %+v", shot)
+	}
+}
+
+func TestTakeReliefWithoutPenaltyAreaFails(t *testing.T) {
+	g := newGameWithCreek(t)
+
+	if err := g.TakeRelief(gogolf.LateralRelief); err == nil {
+		t.Error("expected an error when the ball is not in a penalty area")
+	}
+}
+
+func TestTakeShotRefusedWhileReliefPending(t *testing.T) {
+	g := newGameWithCreek(t)
+	hole := g.GetCurrentHole()
+	g.ScoreCard.RecordStroke(hole)
+	g.applyPenalties(hole, gogolf.Flight{Origin: g.Ball.Location, Landing: at(40, 140), Rest: at(40, 160)})
+	ball := g.Ball.Location
+
+	if _, err := g.TakeShot(0.5, g.DefaultAim()); err == nil {
+		t.Fatal("expected an error playing on before taking relief")
+	}
+	if g.StrokesThisHole() != 1 || g.ScoreCard.PenaltiesThisHole(hole) != 0 {
+		t.Errorf("a refused shot should cost nothing, got %d strokes and %d penalties",
+			g.StrokesThisHole(), g.ScoreCard.PenaltiesThisHole(hole))
+	}
+	if g.Ball.Location != ball || g.PendingRelief() == nil {
+		t.Error("expected the ball to stay in the penalty area waiting for relief")
+	}
+}
@@ -0,0 +1,131 @@
+package game
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"gogolf"
+	"math/rand/v2"
+)
+
+// pcgRandom is the game's own random source: a PCG generator whose state can be saved with a
+// suspended round and restored when it is resumed
+type pcgRandom struct {
+	*rand.Rand
+	source *rand.PCG
+}
+
+func newRandom(seed1, seed2 uint64) gogolf.RandomSource {
+	source := rand.NewPCG(seed1, seed2)
+	return pcgRandom{Rand: rand.New(source), source: source}
+}
+
+func (r pcgRandom) MarshalBinary() ([]byte, error) {
+	return r.source.MarshalBinary()
+}
+
+// restoreRandom picks up a random source from the state saved by MarshalBinary
+func restoreRandom(state []byte) (gogolf.RandomSource, error) {
+	source := &rand.PCG{}
+	if err := source.UnmarshalBinary(state); err != nil {
+		return nil, err
+	}
+	return pcgRandom{Rand: rand.New(source), source: source}, nil
+}
+
+// Suspend captures the round in progress so it can be saved and carried on later with Resume.
+// A round can only be put aside between shots on a hole still being played, and only when its
+// course and random source can be saved with it.
+func (g *Game) Suspend() (gogolf.RoundData, error) {
+	switch {
+	case g.IsRoundComplete():
+		return gogolf.RoundData{}, fmt.Errorf("the round is already over")
+	case g.IsHoleComplete():
+		return gogolf.RoundData{}, fmt.Errorf("hole %d is finished; suspend the round from the next tee", g.GetCurrentHole().Number)
+	case g.pendingRelief != nil:
+		return gogolf.RoundData{}, fmt.Errorf("relief must be taken before the round is suspended")
+	case g.Course.Definition == nil:
+		return gogolf.RoundData{}, fmt.Errorf("course %q was not built from a definition and can't be saved", g.Course.Name)
+	}
+	random, ok := g.random.(encoding.BinaryMarshaler)
+	if !ok {
+		return gogolf.RoundData{}, fmt.Errorf("the round's random source can't be saved")
+	}
+	state, err := random.MarshalBinary()
+	if err != nil {
+		return gogolf.RoundData{}, fmt.Errorf("failed to save the random source: %w", err)
+	}
+
+	round := gogolf.RoundData{
+		Course:           *g.Course.Definition,
+		CurrentHole:      g.CurrentHoleIndex,
+		BallLocation:     g.Ball.Location,
+		PreviousLocation: g.Ball.PrevLocation,
+		ScoreCard:        gogolf.NewScoreCardData(g.ScoreCard),
+		Weather:          g.Weather,
+		Random:           state,
+	}
+	round.Course.Name = g.Course.Name
+	if g.Scoring != nil {
+		round.Scoring = g.Scoring.Name()
+	}
+	if g.lastShotResult != nil {
+		if round.LastShot, err = json.Marshal(g.lastShotResult); err != nil {
+			return gogolf.RoundData{}, fmt.Errorf("failed to save the last shot: %w", err)
+		}
+	}
+	return round, nil
+}
+
+// Resume carries on a round saved by Suspend for golfer, from the shot they were about to play
+func Resume(golfer gogolf.Golfer, round gogolf.RoundData) (*Game, error) {
+	course, err := round.Course.Build()
+	if err != nil {
+		return nil, fmt.Errorf("failed to rebuild the course: %w", err)
+	}
+	if round.CurrentHole < 0 || round.CurrentHole >= len(course.Holes) {
+		return nil, fmt.Errorf("hole index %d is not on %s", round.CurrentHole, course.Name)
+	}
+	random, err := restoreRandom(round.Random)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore the random source: %w", err)
+	}
+	scoring, err := scoringFormatNamed(round.Scoring)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &Game{
+		Golfer:           golfer,
+		Course:           course,
+		ScoreCard:        round.ScoreCard.ToScoreCard(course),
+		CurrentHoleIndex: round.CurrentHole,
+		Scoring:          scoring,
+		Weather:          round.Weather,
+		random:           random,
+	}
+	g.Ball.Location = round.BallLocation
+	g.Ball.PrevLocation = round.PreviousLocation
+	g.resetShotSetup()
+	if len(round.LastShot) > 0 {
+		var last ShotResult
+		if err := json.Unmarshal(round.LastShot, &last); err != nil {
+			return nil, fmt.Errorf("failed to restore the last shot: %w", err)
+		}
+		g.lastShotResult = &last
+	}
+	return g, nil
+}
+
+// scoringFormatNamed finds the format a suspended round was scored in; no name is stroke play
+func scoringFormatNamed(name string) (gogolf.ScoringFormat, error) {
+	if name == "" {
+		return nil, nil
+	}
+	for _, format := range gogolf.ScoringFormats() {
+		if format.Name() == name {
+			return format, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown scoring format %q", name)
+}
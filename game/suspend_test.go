@@ -0,0 +1,141 @@
+package game
+
+import (
+	"encoding/json"
+	"gogolf"
+	"reflect"
+	"testing"
+)
+
+// playComputerShots plays n of the computer's shots, moving on to the next hole whenever one is finished
+func playComputerShots(t *testing.T, g *Game, n int) {
+	t.Helper()
+	for range n {
+		if g.IsRoundComplete() {
+			return
+		}
+		if g.IsHoleComplete() {
+			g.CompleteHole()
+			g.NextHole()
+			if g.IsRoundComplete() {
+				return
+			}
+			g.TeeUp()
+		}
+		if _, err := g.PlayComputerShot(); err != nil {
+			t.Fatalf("PlayComputerShot returned error: %v", err)
+		}
+	}
+}
+
+// suspendAndResume takes the round through the save format and back, as quitting and continuing would
+func suspendAndResume(t *testing.T, g *Game) *Game {
+	t.Helper()
+	round, err := g.Suspend()
+	if err != nil {
+		t.Fatalf("Suspend returned error: %v", err)
+	}
+	saved, err := json.Marshal(round)
+	if err != nil {
+		t.Fatalf("failed to serialize round: %v", err)
+	}
+	var loaded gogolf.RoundData
+	if err := json.Unmarshal(saved, &loaded); err != nil {
+		t.Fatalf("failed to parse round: %v", err)
+	}
+
+	resumed, err := Resume(g.Golfer, loaded)
+	if err != nil {
+		t.Fatalf("Resume returned error: %v", err)
+	}
+	resumed.Computer = g.Computer
+	return resumed
+}
+
+func TestResumePlaysOnExactlyAsBefore(t *testing.T) {
+	uninterrupted := newComputerGame(ClubGolfer, 3, 42)
+	uninterrupted.TeeUp()
+	suspended := newComputerGame(ClubGolfer, 3, 42)
+	suspended.TeeUp()
+	playComputerShots(t, uninterrupted, 5)
+	playComputerShots(t, suspended, 5)
+
+	resumed := suspendAndResume(t, suspended)
+
+	if resumed.CurrentHoleIndex != uninterrupted.CurrentHoleIndex || resumed.Ball != uninterrupted.Ball {
+		t.Fatalf("expected to resume on hole %d at %v, got hole %d at %v",
+			uninterrupted.CurrentHoleIndex, uninterrupted.Ball, resumed.CurrentHoleIndex, resumed.Ball)
+	}
+	if resumed.Weather != uninterrupted.Weather || resumed.Course.Name != uninterrupted.Course.Name {
+		t.Errorf("expected the same course and weather, got %s in %v", resumed.Course.Name, resumed.Weather)
+	}
+	if !reflect.DeepEqual(resumed.GetLastShotResult(), uninterrupted.GetLastShotResult()) {
+		t.Errorf("expected the last shot back, got %+v", resumed.GetLastShotResult())
+	}
+
+	playComputerShots(t, uninterrupted, 40)
+	playComputerShots(t, resumed, 40)
+	if !reflect.DeepEqual(resumed.ScoreCard.Shots, uninterrupted.ScoreCard.Shots) || resumed.ScoreCard.TotalStrokes() != uninterrupted.ScoreCard.TotalStrokes() {
+		t.Errorf("the resumed round should play out shot for shot: %d strokes vs %d",
+			resumed.ScoreCard.TotalStrokes(), uninterrupted.ScoreCard.TotalStrokes())
+	}
+}
+
+func TestResumeKeepsTheCourseNameAndScoring(t *testing.T) {
+	g := newComputerGame(TourPro, 2, 7)
+	g.Course.Name = "The Open"
+	g.Scoring = gogolf.Stableford{}
+	g.TeeUp()
+	playComputerShots(t, g, 1)
+
+	resumed := suspendAndResume(t, g)
+
+	if resumed.Course.Name != "The Open" || resumed.ScoringFormat().Name() != (gogolf.Stableford{}).Name() {
+		t.Errorf("expected The Open in Stableford, got %s in %s", resumed.Course.Name, resumed.ScoringFormat().Name())
+	}
+	if resumed.ScoreCard.TotalStrokes() != 1 || len(resumed.ScoreCard.ShotsThisHole(resumed.GetCurrentHole())) != 1 {
+		t.Errorf("expected the one shot played on the card, got %+v", resumed.ScoreCard)
+	}
+}
+
+func TestSuspendOnlyBetweenShots(t *testing.T) {
+	g := newComputerGame(TourPro, 1, 3)
+	g.TeeUp()
+	for !g.IsHoleComplete() {
+		g.PlayComputerShot()
+	}
+	if _, err := g.Suspend(); err == nil {
+		t.Error("expected an error suspending a finished round")
+	}
+
+	handmade := NewWithCourse(gogolf.NewGolfer("Tester"), gogolf.Course{Name: "Sketch", Holes: []gogolf.Hole{{Number: 1, Par: 3}}}, NewSeededRandom(1))
+	if _, err := handmade.Suspend(); err == nil {
+		t.Error("expected an error suspending a round on a course with no definition")
+	}
+}
+
+func TestResumeRejectsBadRounds(t *testing.T) {
+	g := newComputerGame(TourPro, 1, 3)
+	round, err := g.Suspend()
+	if err != nil {
+		t.Fatalf("Suspend returned error: %v", err)
+	}
+
+	offCourse := round
+	offCourse.CurrentHole = 5
+	if _, err := Resume(g.Golfer, offCourse); err == nil {
+		t.Error("expected an error resuming on a hole the course doesn't have")
+	}
+
+	unknown := round
+	unknown.Scoring = "Calcutta"
+	if _, err := Resume(g.Golfer, unknown); err == nil {
+		t.Error("expected an error resuming in an unknown format")
+	}
+
+	noRandom := round
+	noRandom.Random = nil
+	if _, err := Resume(g.Golfer, noRandom); err == nil {
+		t.Error("expected an error resuming without the random state")
+	}
+}
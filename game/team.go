@@ -0,0 +1,330 @@
+package game
+
+import (
+	"fmt"
+	"gogolf"
+	"strings"
+)
+
+// TeamFormat is how a team of golfers plays each hole together
+type TeamFormat int
+
+const (
+	// Scramble has every teammate hit from the same spot; the team picks the best ball and all play on from there
+	Scramble TeamFormat = iota
+	// BestBall has each teammate play their own ball; the team counts the lowest score on each hole
+	BestBall
+	// Foursomes has two teammates share one ball and take alternate shots, driving on alternate holes
+	Foursomes
+)
+
+func (f TeamFormat) String() string {
+	return [...]string{
+		"Scramble",
+		"Best Ball",
+		"Foursomes",
+	}[f]
+}
+
+// maxTeamStrokes is where a team's hole ends if the ball has not been holed, as for a golfer on their own
+const maxTeamStrokes = 11
+
+// Team is a side of golfers playing the course together. Each teammate keeps their own Game,
+// which holds their golfer, their ball and a card of the shots they hit; the team's ScoreCard
+// records the score the format counts.
+type Team struct {
+	Format  TeamFormat
+	Name    string
+	Players []*Game
+	// ScoreCard is the team's card: the chosen shots in a scramble, every shot of the shared ball
+	// in foursomes and the lowest teammate's score in best ball
+	ScoreCard gogolf.ScoreCard
+	// played marks the teammates who have hit from the current spot in a scramble
+	played []bool
+	// strokesBefore is each teammate's own stroke count when they set up for their current shot
+	strokesBefore []int
+	// next is the teammate due to hit the shared ball in foursomes
+	next     int
+	holedOut bool
+}
+
+// NewTeam creates a team of golfers playing course in format. Foursomes needs exactly two
+// golfers; the other formats take MinPlayers to MaxPlayers. Every random draw comes from rng.
+func NewTeam(format TeamFormat, golfers []gogolf.Golfer, course gogolf.Course, rng gogolf.RandomSource) (*Team, error) {
+	if format == Foursomes && len(golfers) != 2 {
+		return nil, fmt.Errorf("foursomes needs 2 players, got %d", len(golfers))
+	}
+	if len(golfers) < MinPlayers || len(golfers) > MaxPlayers {
+		return nil, fmt.Errorf("a team needs %d to %d players, got %d", MinPlayers, MaxPlayers, len(golfers))
+	}
+	team := &Team{
+		Format:        format,
+		ScoreCard:     gogolf.NewScoreCard(course),
+		played:        make([]bool, len(golfers)),
+		strokesBefore: make([]int, len(golfers)),
+	}
+	names := make([]string, len(golfers))
+	for i, golfer := range golfers {
+		g := NewWithCourse(golfer, course, rng)
+		if i > 0 {
+			g.Weather = team.Players[0].Weather
+		}
+		team.Players = append(team.Players, g)
+		names[i] = golfer.Name
+	}
+	team.Name = strings.Join(names, " & ")
+	return team, nil
+}
+
+// NewTeamWithSeed generates the course and plays the round from the same seed
+func NewTeamWithSeed(format TeamFormat, golfers []gogolf.Golfer, holeCount int, seed uint64) (*Team, error) {
+	return NewTeam(format, golfers, gogolf.GenerateCourse(holeCount, seed), NewSeededRandom(seed))
+}
+
+// SetScoring scores the team's card in format
+func (t *Team) SetScoring(format gogolf.ScoringFormat) {
+	for _, g := range t.Players {
+		g.Scoring = format
+	}
+}
+
+// ScoringFormat is the format the team's card is scored in
+func (t *Team) ScoringFormat() gogolf.ScoringFormat {
+	return t.Players[0].ScoringFormat()
+}
+
+// TeeUp puts every teammate's ball on the current hole's tee. In foursomes the first
+// teammate drives on odd-numbered holes and the second on even ones.
+func (t *Team) TeeUp() {
+	for i, g := range t.Players {
+		g.TeeUp()
+		t.played[i] = false
+		t.strokesBefore[i] = g.StrokesThisHole()
+	}
+	t.next = t.Players[0].CurrentHoleIndex % 2
+	t.holedOut = false
+}
+
+// GetCurrentHole is the hole the team is playing
+func (t *Team) GetCurrentHole() gogolf.Hole {
+	return t.Players[0].GetCurrentHole()
+}
+
+// GetContext is player's view of the round. Except in best ball, where each teammate plays
+// their own ball, it shows the team's card rather than the player's own.
+func (t *Team) GetContext(player int) Context {
+	ctx := t.Players[player].GetContext()
+	if t.Format != BestBall {
+		ctx.ScoreCard = t.ScoreCard
+	}
+	ctx.Points = t.Points()
+	return ctx
+}
+
+// NextToPlay returns the teammate who hits next, or -1 once the hole is complete or while a
+// scramble is waiting for the team to choose a ball. In a scramble teammates hit in order;
+// in best ball the ball furthest from the hole plays first.
+func (t *Team) NextToPlay() int {
+	if t.IsHoleComplete() {
+		return -1
+	}
+	switch t.Format {
+	case Scramble:
+		for i, played := range t.played {
+			if !played {
+				return i
+			}
+		}
+		return -1
+	case Foursomes:
+		return t.next
+	}
+
+	away := -1
+	furthest := gogolf.Unit(-1)
+	for i, g := range t.Players {
+		if g.IsHoleComplete() {
+			continue
+		}
+		if distance := g.Ball.Location.Distance(g.GetCurrentHole().HoleLocation); distance > furthest {
+			away, furthest = i, distance
+		}
+	}
+	return away
+}
+
+// RecordShot tells the team that player has hit, once they have played their shot and taken
+// any relief that followed. Outside best ball only the golfer NextToPlay named may have hit.
+// In foursomes the stroke and any penalties go on the team's card and the partner plays the
+// ball next. In a scramble a holed shot is chosen at once, otherwise the team chooses when
+// everyone has hit.
+func (t *Team) RecordShot(player int) error {
+	if player < 0 || player >= len(t.Players) {
+		return fmt.Errorf("invalid player %d", player)
+	}
+	if next := t.NextToPlay(); t.Format != BestBall && next != player {
+		return fmt.Errorf("%s is not next to play", t.Players[player].Golfer.Name)
+	}
+	g := t.Players[player]
+	if g.PendingRelief() != nil {
+		return fmt.Errorf("%s must take relief before the shot is recorded", g.Golfer.Name)
+	}
+
+	switch t.Format {
+	case Scramble:
+		t.played[player] = true
+		if g.HasHoledOut() {
+			return t.ChooseBall(player)
+		}
+	case Foursomes:
+		t.playOn(player)
+		t.next = 1 - player
+	}
+	return nil
+}
+
+// AwaitingChoice reports whether every teammate has hit in a scramble and the team must choose a ball
+func (t *Team) AwaitingChoice() bool {
+	if t.Format != Scramble || t.IsHoleComplete() {
+		return false
+	}
+	for _, played := range t.played {
+		if !played {
+			return false
+		}
+	}
+	return true
+}
+
+// SuggestBall suggests the scramble shot to play on from: the ball nearest the hole, preferring
+// one that cost no penalty
+func (t *Team) SuggestBall() int {
+	best := -1
+	var bestPenalties int
+	var bestDistance gogolf.Unit
+	for i, g := range t.Players {
+		if !t.played[i] {
+			continue
+		}
+		penalties := 0
+		if shot := g.GetLastShotResult(); shot != nil {
+			penalties = shot.PenaltyStrokes
+		}
+		distance := g.Ball.Location.Distance(g.GetCurrentHole().HoleLocation)
+		if g.HasHoledOut() {
+			distance = 0
+		}
+		if best < 0 || penalties < bestPenalties || (penalties == bestPenalties && distance < bestDistance) {
+			best, bestPenalties, bestDistance = i, penalties, distance
+		}
+	}
+	return best
+}
+
+// ChooseBall picks player's scramble shot as the team's: it goes on the team's card
+// and every teammate plays their next shot from where that ball lies
+func (t *Team) ChooseBall(player int) error {
+	if t.Format != Scramble {
+		return fmt.Errorf("balls are only chosen in a scramble")
+	}
+	if player < 0 || player >= len(t.Players) || !t.played[player] {
+		return fmt.Errorf("player %d has not hit from this spot", player)
+	}
+	t.playOn(player)
+	for i := range t.played {
+		t.played[i] = false
+	}
+	return nil
+}
+
+// playOn counts player's shot on the team's card, with its details, and puts every teammate's ball where it finished
+func (t *Team) playOn(player int) {
+	g := t.Players[player]
+	hole := g.GetCurrentHole()
+	strokes := g.StrokesThisHole() - t.strokesBefore[player]
+	penalties := 0
+	if shot := g.GetLastShotResult(); shot != nil {
+		penalties = shot.PenaltyStrokes
+	}
+	t.record(hole, strokes, penalties)
+	shots := g.ScoreCard.ShotsThisHole(hole)
+	for _, shot := range shots[max(len(shots)-(strokes-penalties), 0):] {
+		t.ScoreCard.AddShot(hole, shot)
+	}
+	t.holedOut = g.HasHoledOut()
+
+	for i, teammate := range t.Players {
+		teammate.Ball = g.Ball
+		t.strokesBefore[i] = teammate.StrokesThisHole()
+	}
+}
+
+// record adds strokes to the team's card for hole, penalties of them as penalty strokes
+func (t *Team) record(hole gogolf.Hole, strokes, penalties int) {
+	for range penalties {
+		t.ScoreCard.RecordPenalty(hole)
+	}
+	for range strokes - penalties {
+		t.ScoreCard.RecordStroke(hole)
+	}
+}
+
+// IsHoleComplete reports whether the team has finished the current hole: the ball is holed,
+// the team has reached the stroke limit, or in best ball every teammate has finished
+func (t *Team) IsHoleComplete() bool {
+	if t.Format == BestBall {
+		for _, g := range t.Players {
+			if !g.IsHoleComplete() {
+				return false
+			}
+		}
+		return true
+	}
+	return t.holedOut || t.ScoreCard.TotalStrokesThisHole(t.GetCurrentHole()) >= maxTeamStrokes
+}
+
+// IsRoundComplete reports whether the team has played every hole
+func (t *Team) IsRoundComplete() bool {
+	return t.Players[0].IsRoundComplete()
+}
+
+// CompleteHole settles the team's score for the hole and pays every teammate what the scoring
+// format rewards the team's card with. In best ball the lowest teammate's score is the team's.
+func (t *Team) CompleteHole() int {
+	hole := t.GetCurrentHole()
+	if t.Format == BestBall {
+		best := t.Players[0]
+		for _, g := range t.Players[1:] {
+			if g.StrokesThisHole() < best.StrokesThisHole() {
+				best = g
+			}
+		}
+		t.record(hole, best.StrokesThisHole(), best.ScoreCard.PenaltiesThisHole(hole))
+	}
+	reward := t.ScoringFormat().HoleRewards([]gogolf.ScoreCard{t.ScoreCard}, hole)[0]
+	for _, g := range t.Players {
+		g.Golfer.AddMoney(reward)
+	}
+	return reward
+}
+
+// NextHole moves the team on to the next hole
+func (t *Team) NextHole() {
+	for _, g := range t.Players {
+		g.NextHole()
+	}
+}
+
+// Points is the team's result in the scoring format over the holes it has finished
+func (t *Team) Points() int {
+	finished := t.Players[0].CurrentHoleIndex
+	if t.IsRoundComplete() {
+		finished = len(t.ScoreCard.Course.Holes)
+	} else if t.IsHoleComplete() && t.Format != BestBall {
+		finished++
+	}
+	if finished == 0 {
+		return 0
+	}
+	return t.ScoringFormat().Score([]gogolf.ScoreCard{t.ScoreCard}, t.ScoreCard.Course.Holes[finished-1].Number)[0]
+}
@@ -0,0 +1,183 @@
+package game
+
+import (
+	"gogolf"
+	"testing"
+)
+
+func newTestTeam(t *testing.T, format TeamFormat, names ...string) *Team {
+	t.Helper()
+	golfers := make([]gogolf.Golfer, len(names))
+	for i, name := range names {
+		golfers[i] = gogolf.NewGolfer(name)
+	}
+	team, err := NewTeamWithSeed(format, golfers, 3, 11)
+	if err != nil {
+		t.Fatalf("NewTeamWithSeed returned error: %v", err)
+	}
+	for _, g := range team.Players {
+		g.Weather = gogolf.CalmWeather()
+	}
+	team.TeeUp()
+	return team
+}
+
+func TestNewTeamRejectsTeamSize(t *testing.T) {
+	course := gogolf.GenerateCourse(3, 1)
+	three := []gogolf.Golfer{gogolf.NewGolfer("A"), gogolf.NewGolfer("B"), gogolf.NewGolfer("C")}
+
+	if _, err := NewTeam(Foursomes, three, course, NewSeededRandom(1)); err == nil {
+		t.Error("expected an error for foursomes with three players")
+	}
+	if _, err := NewTeam(Scramble, three[:1], course, NewSeededRandom(1)); err == nil {
+		t.Error("expected an error for a team of one")
+	}
+	team, err := NewTeam(Scramble, three, course, NewSeededRandom(1))
+	if err != nil {
+		t.Fatalf("NewTeam returned error: %v", err)
+	}
+	if team.Name != "A & B & C" {
+		t.Errorf("Name = %q", team.Name)
+	}
+}
+
+func TestScrambleEveryoneHitsThenPlaysTheChosenBall(t *testing.T) {
+	team := newTestTeam(t, Scramble, "Alice", "Bob")
+
+	for want := range team.Players {
+		i := team.NextToPlay()
+		if i != want {
+			t.Fatalf("NextToPlay() = %d, want %d", i, want)
+		}
+		g := team.Players[i]
+		takeShot(t, g, 0.5+0.4*float64(i), g.DefaultAim())
+		if err := team.RecordShot(i); err != nil {
+			t.Fatalf("RecordShot returned error: %v", err)
+		}
+	}
+	if !team.AwaitingChoice() || team.NextToPlay() != -1 {
+		t.Fatal("the team should choose a ball once everyone has hit")
+	}
+
+	chosen := team.SuggestBall()
+	rest := team.Players[chosen].Ball.Location
+	penalties := team.Players[chosen].GetLastShotResult().PenaltyStrokes
+	if err := team.ChooseBall(chosen); err != nil {
+		t.Fatalf("ChooseBall returned error: %v", err)
+	}
+
+	if got := team.ScoreCard.TotalStrokesThisHole(team.GetCurrentHole()); got != 1+penalties {
+		t.Errorf("team strokes = %d, want one for the chosen shot plus %d penalties", got, penalties)
+	}
+	for _, g := range team.Players {
+		if g.Ball.Location != rest {
+			t.Errorf("%s should play on from the chosen ball", g.Golfer.Name)
+		}
+	}
+	if team.NextToPlay() != 0 {
+		t.Error("everyone should hit again from the chosen spot")
+	}
+}
+
+func TestFoursomesAlternateShotsWithOneBall(t *testing.T) {
+	team := newTestTeam(t, Foursomes, "Alice", "Bob")
+	alice, bob := team.Players[0], team.Players[1]
+
+	if team.NextToPlay() != 0 {
+		t.Fatal("Alice should drive the first hole")
+	}
+	if err := team.RecordShot(1); err == nil {
+		t.Error("expected an error when Bob plays out of turn")
+	}
+	takeShot(t, alice, 1.0, alice.DefaultAim())
+	if err := team.RecordShot(0); err != nil {
+		t.Fatalf("RecordShot returned error: %v", err)
+	}
+
+	if bob.Ball.Location != alice.Ball.Location {
+		t.Error("Bob should play the shared ball from where Alice's shot finished")
+	}
+	if team.NextToPlay() != 1 {
+		t.Error("Bob should play the second shot")
+	}
+	if team.ScoreCard.TotalStrokesThisHole(team.GetCurrentHole()) != alice.StrokesThisHole() {
+		t.Error("the team card should record Alice's stroke and any penalties")
+	}
+
+	team.NextHole()
+	team.TeeUp()
+	if team.NextToPlay() != 1 {
+		t.Error("Bob should drive the second hole")
+	}
+}
+
+func TestBestBallCountsTheLowestScore(t *testing.T) {
+	team := newTestTeam(t, BestBall, "Alice", "Bob")
+	for i, strokes := range []int{5, 4} {
+		g := team.Players[i]
+		for range strokes {
+			g.ScoreCard.RecordStroke(g.GetCurrentHole())
+		}
+		g.lastShotResult = &ShotResult{HoledOut: true}
+	}
+	if !team.IsHoleComplete() {
+		t.Fatal("the hole should be complete once both have holed out")
+	}
+
+	money := team.Players[0].Golfer.Money
+	reward := team.CompleteHole()
+	if got := team.ScoreCard.TotalStrokesThisHole(team.GetCurrentHole()); got != 4 {
+		t.Errorf("team strokes = %d, want Bob's 4", got)
+	}
+	if reward != gogolf.CalculateHoleReward(team.GetCurrentHole().Par, 4) {
+		t.Errorf("reward = %d, want the team score's reward", reward)
+	}
+	for _, g := range team.Players {
+		if g.Golfer.Money != money+reward {
+			t.Errorf("%s has %d money, want every teammate paid %d", g.Golfer.Name, g.Golfer.Money, reward)
+		}
+	}
+}
+
+func TestTeamsPlayAHoleOut(t *testing.T) {
+	for _, format := range []TeamFormat{Scramble, BestBall, Foursomes} {
+		team := newTestTeam(t, format, "Alice", "Bob")
+		for shots := 0; !team.IsHoleComplete(); shots++ {
+			if shots > 40 {
+				t.Fatalf("%s: the hole never finished", format)
+			}
+			if team.AwaitingChoice() {
+				team.ChooseBall(team.SuggestBall())
+				continue
+			}
+			i := team.NextToPlay()
+			g := team.Players[i]
+			takeShot(t, g, 1.0, g.DefaultAim())
+			if relief := g.PendingRelief(); relief != nil {
+				if err := g.TakeRelief(relief.Options[0].Option); err != nil {
+					t.Fatalf("%s: TakeRelief returned error: %v", format, err)
+				}
+			}
+			if err := team.RecordShot(i); err != nil {
+				t.Fatalf("%s: RecordShot returned error: %v", format, err)
+			}
+		}
+		team.CompleteHole()
+		if team.ScoreCard.TotalStrokesThisHole(team.GetCurrentHole()) == 0 {
+			t.Errorf("%s: the team card has no score for the hole", format)
+		}
+	}
+}
+
+func TestRecordShotRefusedWhileReliefPending(t *testing.T) {
+	team := newTestTeam(t, Foursomes, "Alice", "Bob")
+	i := team.NextToPlay()
+	team.Players[i].pendingRelief = &Relief{}
+
+	if err := team.RecordShot(i); err == nil {
+		t.Error("expected an error recording a shot before relief is taken")
+	}
+	if team.NextToPlay() != i {
+		t.Errorf("NextToPlay() = %d, want %d still to play", team.NextToPlay(), i)
+	}
+}
@@ -80,3 +80,23 @@ func (pointA Point) Distance(pointB Point) Unit {
 	ys := math.Pow(float64(pointB.Y-pointA.Y), 2)
 	return Unit(math.Sqrt(math.Abs(xs + ys)))
 }
+
+// ClosestPointOnSegment returns the point on the segment from a to b nearest to p
+func (p Point) ClosestPointOnSegment(a, b Point) Point {
+	segment := a.Direction(b)
+	lengthSquared := segment.Dot(segment)
+	if lengthSquared == 0 {
+		return a
+	}
+	t := a.Direction(p).Dot(segment) / lengthSquared
+	t = math.Max(0, math.Min(1, t))
+	return Point{
+		X: int(math.Round(float64(a.X) + t*segment.X)),
+		Y: int(math.Round(float64(a.Y) + t*segment.Y)),
+	}
+}
+
+// DistanceToSegment returns how far p is from the nearest point on the segment from a to b
+func (p Point) DistanceToSegment(a, b Point) Unit {
+	return p.Distance(p.ClosestPointOnSegment(a, b))
+}
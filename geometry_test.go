@@ -149,3 +149,31 @@ func TestPoint_Distance(t *testing.T) {
 		}
 	}
 }
+
+func TestPoint_DistanceToSegment(t *testing.T) {
+	a := Point{X: 0, Y: 0}
+	b := Point{X: 100, Y: 0}
+
+	tests := []struct {
+		name     string
+		point    Point
+		expected Unit
+	}{
+		{"above the middle", Point{X: 50, Y: 30}, 30},
+		{"beyond the end", Point{X: 130, Y: 40}, 50},
+		{"before the start", Point{X: -3, Y: 4}, 5},
+		{"on the segment", Point{X: 20, Y: 0}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.point.DistanceToSegment(a, b); got != tt.expected {
+				t.Errorf("DistanceToSegment = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+
+	if got := (Point{X: 3, Y: 4}).DistanceToSegment(a, a); got != 5 {
+		t.Errorf("DistanceToSegment of a zero-length segment = %v, want 5", got)
+	}
+}
@@ -8,11 +8,16 @@ type GolfBall struct {
 }
 
 func (ball *GolfBall) TeeUp() {
-	ball.Location = Point{0, 0}
-	ball.PrevLocation = Point{0, 0}
+	ball.TeeUpAt(Point{0, 0})
 	fmt.Println("Ball teed up")
 }
 
+// TeeUpAt places the ball on the tee at the given location
+func (ball *GolfBall) TeeUpAt(location Point) {
+	ball.Location = location
+	ball.PrevLocation = location
+}
+
 // GetLie returns the lie type at the ball's current location
 func (b GolfBall) GetLie(hole *Hole) LieType {
 	return hole.GetLieAtPosition(b.Location)
@@ -28,6 +33,11 @@ func (b GolfBall) CheckForCollision(bPath Vector, location Point) (bool, Unit) {
 	toLocVec := Vector{X: float64(location.X - b.PrevLocation.X), Y: float64(location.Y - b.PrevLocation.Y)}
 	dotProduct := toLocVec.Dot(bPath)
 	squaredLengthBPath := bPath.Dot(bPath)
+	if squaredLengthBPath == 0 {
+		// the ball did not move, so the only point to check is where it sits
+		distance := b.Location.Distance(location)
+		return distance < 1, distance
+	}
 	projectionFactor := dotProduct / squaredLengthBPath
 
 	closestPoint := Point{
@@ -51,9 +61,14 @@ func (b GolfBall) CheckForCollision(bPath Vector, location Point) (bool, Unit) {
 // the collision detection on the hole is going to be really important.  A simple alternative
 // for now, could be to check if it is within a few points of the hole.  Though I think the collision
 // will be much better.
-func (ball *GolfBall) ReceiveHit(club Club, power float32, direction Vector) (path Vector) {
-	yards := Yard(float32(club.Distance) * power)
+//
+// The weather stretches or shortens the carry along direction and a crosswind drifts the ball sideways.
+func (ball *GolfBall) ReceiveHit(club Club, power float32, direction Vector, conditions Conditions) (path Vector) {
+	yards := Yard(float64(float32(club.Distance)*power) * conditions.CarryMultiplier(direction))
 	ball.PrevLocation = Point{ball.Location.X, ball.Location.Y}
 	ball.Location = ball.Location.Move(direction, float64(yards.Units()))
+	if drift := conditions.Drift(direction, yards); drift != 0 {
+		ball.Location = ball.Location.Move(direction.Rotate(90), float64(drift.Units()))
+	}
 	return Vector{X: float64(ball.Location.X - ball.PrevLocation.X), Y: float64(ball.Location.Y - ball.PrevLocation.Y)}
 }
@@ -22,6 +22,8 @@ type Club struct {
 	// 1 would mean that a miss hit's accuracy is only affected by the margin
 	// 0 would mean that it is basically a random shot
 	Forgiveness float32
+	// loft in degrees; higher lofts carry a larger share of the distance and roll out less
+	Loft float32
 }
 
 func (c Club) AccuracyDegrees() float32 {
@@ -29,20 +31,20 @@ func (c Club) AccuracyDegrees() float32 {
 }
 
 func DefaultClubs() (clubs []Club) {
-	driver := Club{Name: "Driver", Distance: 280, Accuracy: .75, Forgiveness: .8}
-	threeWood := Club{Name: "3 Wood", Distance: 250, Accuracy: .8, Forgiveness: .8}
-	fiveWood := Club{Name: "5 Wood", Distance: 235, Accuracy: .8, Forgiveness: .8}
-	fourIron := Club{Name: "4 Iron", Distance: 215, Accuracy: .85, Forgiveness: .8}
-	fiveIron := Club{Name: "5 Iron", Distance: 200, Accuracy: .85, Forgiveness: .8}
-	sixIron := Club{Name: "6 Iron", Distance: 190, Accuracy: .85, Forgiveness: .8}
-	sevenIron := Club{Name: "7 Iron", Distance: 180, Accuracy: .9, Forgiveness: .8}
-	eightIron := Club{Name: "8 Iron", Distance: 170, Accuracy: .9, Forgiveness: .8}
-	nineIron := Club{Name: "8 Iron", Distance: 160, Accuracy: .9, Forgiveness: .8}
-	pitchingWedge := Club{Name: "PW", Distance: 150, Accuracy: .95, Forgiveness: .8}
-	gapWedge := Club{Name: "GW", Distance: 140, Accuracy: .95, Forgiveness: .8}
-	sandWedge := Club{Name: "SW", Distance: 125, Accuracy: .95, Forgiveness: .8}
-	lobWedge := Club{Name: "LW", Distance: 100, Accuracy: .95, Forgiveness: .8}
-	putter := Club{Name: "Putter", Distance: 40, Accuracy: 1, Forgiveness: .95}
+	driver := Club{Name: "Driver", Distance: 280, Accuracy: .75, Forgiveness: .8, Loft: 10.5}
+	threeWood := Club{Name: "3 Wood", Distance: 250, Accuracy: .8, Forgiveness: .8, Loft: 15}
+	fiveWood := Club{Name: "5 Wood", Distance: 235, Accuracy: .8, Forgiveness: .8, Loft: 18}
+	fourIron := Club{Name: "4 Iron", Distance: 215, Accuracy: .85, Forgiveness: .8, Loft: 22}
+	fiveIron := Club{Name: "5 Iron", Distance: 200, Accuracy: .85, Forgiveness: .8, Loft: 25}
+	sixIron := Club{Name: "6 Iron", Distance: 190, Accuracy: .85, Forgiveness: .8, Loft: 28}
+	sevenIron := Club{Name: "7 Iron", Distance: 180, Accuracy: .9, Forgiveness: .8, Loft: 32}
+	eightIron := Club{Name: "8 Iron", Distance: 170, Accuracy: .9, Forgiveness: .8, Loft: 36}
+	nineIron := Club{Name: "8 Iron", Distance: 160, Accuracy: .9, Forgiveness: .8, Loft: 40}
+	pitchingWedge := Club{Name: "PW", Distance: 150, Accuracy: .95, Forgiveness: .8, Loft: 46}
+	gapWedge := Club{Name: "GW", Distance: 140, Accuracy: .95, Forgiveness: .8, Loft: 50}
+	sandWedge := Club{Name: "SW", Distance: 125, Accuracy: .95, Forgiveness: .8, Loft: 56}
+	lobWedge := Club{Name: "LW", Distance: 100, Accuracy: .95, Forgiveness: .8, Loft: 60}
+	putter := Club{Name: "Putter", Distance: 40, Accuracy: 1, Forgiveness: .95, Loft: 3}
 	clubs = []Club{driver, threeWood, fiveWood, fourIron, fiveIron, sixIron, sevenIron, eightIron, nineIron, pitchingWedge, gapWedge, sandWedge, lobWedge, putter}
 	return
 }
@@ -57,6 +59,10 @@ type Golfer struct {
 	Ball      *Ball
 	Glove     *Glove
 	Shoes     *Shoes
+	// Career is the golfer's tour career; nil until they turn pro
+	Career *Career
+	// ScoringRecord is every round the golfer has posted for handicap, oldest first
+	ScoringRecord []PostedScore
 }
 
 func NewGolfer(name string) Golfer {
@@ -120,6 +126,15 @@ func (g Golfer) GetBestClubForLie(distance Yard, lie LieType) Club {
 	return c
 }
 
+// SelectClubForLie lets selector choose from the clubs that can be played from lie. A nil
+// selector makes the golfer's usual pick from GetBestClubForLie.
+func (g Golfer) SelectClubForLie(selector ClubSelector, distance Yard, lie LieType) Club {
+	if selector == nil {
+		return g.GetBestClubForLie(distance, lie)
+	}
+	return LieAwareSelector{Lie: lie, Selector: selector}.SelectClub(g.Clubs, distance)
+}
+
 func (g Golfer) SkillCheck(d DiceRoller, targetNumber int) SkillCheckResult {
 	total, rolls := d.RollN(3)
 	margin := targetNumber - total
@@ -470,3 +470,26 @@ func TestGolfer_GetBestClubForLie_ShortDistanceOffGreenUsesWedge(t *testing.T) {
 		t.Errorf("GetBestClubForLie(10, Fairway) = %v, want LW (closest club for short chip)", club.Name)
 	}
 }
+
+func TestGolfer_SelectClubForLie_NilSelectorMakesUsualPick(t *testing.T) {
+	golfer := NewGolfer("Test")
+
+	for _, lie := range []LieType{Tee, Fairway, Rough, DeepRough, Bunker, Green} {
+		for _, distance := range []Yard{10, 150, 200, 400} {
+			want := golfer.GetBestClubForLie(distance, lie)
+			if club := golfer.SelectClubForLie(nil, distance, lie); club.Name != want.Name {
+				t.Errorf("SelectClubForLie(nil, %v, %v) = %s, want %s", distance, lie, club.Name, want.Name)
+			}
+		}
+	}
+}
+
+func TestGolfer_SelectClubForLie_SelectorOnlySeesPlayableClubs(t *testing.T) {
+	golfer := NewGolfer("Test")
+
+	club := golfer.SelectClubForLie(AggressiveSelector{}, 400, Fairway)
+
+	if club.Name != "3 Wood" {
+		t.Errorf("SelectClubForLie(Aggressive, 400, Fairway) = %s, want 3 Wood", club.Name)
+	}
+}
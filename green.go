@@ -0,0 +1,163 @@
+package gogolf
+
+import "math"
+
+// DefaultStimp is the green speed a putt's pace is judged against
+const DefaultStimp = 10.0
+
+const (
+	// greenFriction is how quickly a rolling ball slows on a flat green at DefaultStimp, in yards/s²
+	greenFriction = 0.6
+	// slopePull is the share of greenFriction each percent of grade adds in the downhill direction
+	slopePull = 0.2
+	// offGreenDrag multiplies friction once a putt runs off the putting surface
+	offGreenDrag = 4.0
+	// stopSpeed is the speed, in yards/s, below which a ball settles unless the slope keeps it moving
+	stopSpeed   = 0.05
+	rollStep    = 0.02
+	maxRollTime = 30.0
+)
+
+// SlopeAtPosition returns the slope of the cell at a position, or no slope off the grid
+func (g CourseGrid) SlopeAtPosition(pos Point) Vector {
+	row, col := g.positionToIndices(pos)
+	if !g.InBounds(pos) {
+		return Vector{}
+	}
+	return g.Cells[row][col].Slope
+}
+
+// SetSlopeAtPosition sets the slope of the cell at a position.
+// Does nothing if position is out of bounds
+func (g *CourseGrid) SetSlopeAtPosition(pos Point, slope Vector) {
+	row, col := g.positionToIndices(pos)
+	if !g.InBounds(pos) {
+		return
+	}
+	g.Cells[row][col].Slope = slope
+}
+
+// SlopeAt returns the downhill slope of the green at a position, in percent grade.
+// Anywhere off the green is treated as flat.
+func (h Hole) SlopeAt(pos Point) Vector {
+	if h.Grid == nil || h.GetLieAtPosition(pos) != Green {
+		return Vector{}
+	}
+	return h.Grid.SlopeAtPosition(pos)
+}
+
+// GreenSpeed returns the stimp of the hole's greens
+func (h Hole) GreenSpeed() float64 {
+	if h.Grid == nil || h.Grid.Stimp <= 0 {
+		return DefaultStimp
+	}
+	return h.Grid.Stimp
+}
+
+// RollOnGreen rolls a ball from a point along direction, struck with the pace to roll distance
+// on a flat green at DefaultStimp. Faster greens carry it further, the slope bends and speeds
+// or slows it, and it pulls up quickly once it runs off the green. The returned path starts at from
+// and ends where the ball comes to rest.
+func (h Hole) RollOnGreen(from Point, direction Vector, distance Yard) []Point {
+	path := []Point{from}
+	if distance <= 0 || direction.Magnitude() == 0 {
+		return path
+	}
+
+	unitsPerYard := float64(Yard(1).Units())
+	friction := greenFriction * DefaultStimp / h.GreenSpeed()
+	heading := direction.Normalize()
+	speed := math.Sqrt(2 * greenFriction * float64(distance))
+	velocity := Vector{X: heading.X * speed, Y: heading.Y * speed}
+	x, y := float64(from.X), float64(from.Y)
+
+	for elapsed := 0.0; elapsed < maxRollTime; elapsed += rollStep {
+		position := Point{X: int(math.Round(x)), Y: int(math.Round(y))}
+		drag := friction
+		if h.Grid != nil && h.GetLieAtPosition(position) != Green {
+			drag *= offGreenDrag
+		}
+		slope := h.SlopeAt(position)
+		pull := Vector{X: slope.X * slopePull * greenFriction, Y: slope.Y * slopePull * greenFriction}
+
+		speed := velocity.Magnitude()
+		if speed < stopSpeed && pull.Magnitude() <= drag {
+			break
+		}
+
+		acceleration := pull
+		if speed > 0 {
+			acceleration = acceleration.Subtract(Vector{X: velocity.X / speed * drag, Y: velocity.Y / speed * drag})
+		}
+		velocity = Vector{X: velocity.X + acceleration.X*rollStep, Y: velocity.Y + acceleration.Y*rollStep}
+		x += velocity.X * rollStep * unitsPerYard
+		y += velocity.Y * rollStep * unitsPerYard
+
+		next := Point{X: int(math.Round(x)), Y: int(math.Round(y))}
+		if next != path[len(path)-1] {
+			path = append(path, next)
+		}
+	}
+	return path
+}
+
+// DetectHoleOutOnPath applies DetectHoleOut to a curving roll, checking each step of the path
+// with CheckForCollision and judging the closest pass against where the ball came to rest
+func (h Hole) DetectHoleOutOnPath(path []Point) bool {
+	if len(path) == 0 {
+		return false
+	}
+	rest := path[len(path)-1]
+	directHit := false
+	closest := rest.Distance(h.HoleLocation)
+	for i := 1; i < len(path); i++ {
+		segment := GolfBall{PrevLocation: path[i-1], Location: path[i]}
+		hit, distance := segment.CheckForCollision(path[i-1].Direction(path[i]), h.HoleLocation)
+		directHit = directHit || hit
+		closest = min(closest, distance)
+	}
+	hitAndStoppedInHole := directHit && rest.Distance(h.HoleLocation) <= Foot(16).Units()
+	closeEnough := closest <= Unit(2) && rest.Distance(h.HoleLocation) <= Yard(1).Units()
+	return hitAndStoppedInHole || closeEnough
+}
+
+// GreenRead is a golfer's read of a putt: how far it breaks, whether it plays uphill and how quick the green is
+type GreenRead struct {
+	// Break is how far a putt struck straight at the hole with the right pace passes to the right (+) or left (-) of it
+	Break Foot
+	// Grade is the average percent grade along the line, positive when the putt is uphill
+	Grade float64
+	Stimp float64
+}
+
+// ReadPutt reads the green between a ball and the hole
+func (h Hole) ReadPutt(from Point) GreenRead {
+	read := GreenRead{Stimp: h.GreenSpeed()}
+	line := from.Direction(h.HoleLocation)
+	length := line.Magnitude()
+	if length == 0 {
+		return read
+	}
+	forward := line.Normalize()
+
+	const samples = 10
+	for i := 0; i <= samples; i++ {
+		position := from.Move(line, length*float64(i)/samples)
+		read.Grade -= h.SlopeAt(position).Dot(forward) / (samples + 1)
+	}
+
+	distance := Unit(length).Yards() * Yard(DefaultStimp/read.Stimp)
+	path := h.RollOnGreen(from, line, distance)
+	aim := NewAim(AimFlag, h.HoleLocation)
+
+	// measure the break where the ball passes the hole, or where it stops if it comes up short
+	passing := path[len(path)-1]
+	for _, point := range path {
+		if from.Direction(point).Dot(forward) >= length {
+			passing = point
+			break
+		}
+	}
+	read.Break = aim.OffLine(from, passing).Feet()
+	return read
+}
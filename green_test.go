@@ -0,0 +1,156 @@
+package gogolf
+
+import (
+	"math"
+	"testing"
+)
+
+// puttingGreen is a 40 yard square of green with the hole 20 yards up the middle
+func puttingGreen(slope Vector, stimp float64) Hole {
+	hole := NewHoleWithGrid(1, 3, yards(20, 30), Size{}, 40, 40, 1)
+	hole.Grid.Fill(Green)
+	hole.Grid.Stimp = stimp
+	for i := range hole.Grid.Cells {
+		for j := range hole.Grid.Cells[i] {
+			hole.Grid.Cells[i][j].Slope = slope
+		}
+	}
+	return *hole
+}
+
+func rest(path []Point) Point {
+	return path[len(path)-1]
+}
+
+func TestHole_RollOnGreenFlat(t *testing.T) {
+	hole := puttingGreen(Vector{}, DefaultStimp)
+
+	path := hole.RollOnGreen(yards(20, 10), Vector{X: 0, Y: 1}, 10)
+
+	rolled := yards(20, 10).Distance(rest(path)).Yards()
+	if rolled < 9.5 || rolled > 10.5 {
+		t.Errorf("flat putt rolled %.2f yards, want about 10", rolled)
+	}
+	if rest(path).X != yards(20, 10).X {
+		t.Errorf("flat putt should roll straight, finished at %+v", rest(path))
+	}
+}
+
+func TestHole_RollOnGreenStimp(t *testing.T) {
+	slow := puttingGreen(Vector{}, 8)
+	fast := puttingGreen(Vector{}, 13)
+	from := yards(20, 10)
+
+	slowRoll := from.Distance(rest(slow.RollOnGreen(from, Vector{X: 0, Y: 1}, 10)))
+	fastRoll := from.Distance(rest(fast.RollOnGreen(from, Vector{X: 0, Y: 1}, 10)))
+
+	if fastRoll <= slowRoll {
+		t.Errorf("a fast green should roll further: fast %v, slow %v", fastRoll, slowRoll)
+	}
+}
+
+func TestHole_RollOnGreenSlope(t *testing.T) {
+	from := yards(20, 10)
+
+	sidehill := puttingGreen(Vector{X: 2, Y: 0}, DefaultStimp)
+	if finish := rest(sidehill.RollOnGreen(from, Vector{X: 0, Y: 1}, 10)); finish.X <= from.X {
+		t.Errorf("putt across a slope falling right should break right, finished at %+v", finish)
+	}
+
+	uphill := puttingGreen(Vector{X: 0, Y: -2}, DefaultStimp)
+	downhill := puttingGreen(Vector{X: 0, Y: 2}, DefaultStimp)
+	up := from.Distance(rest(uphill.RollOnGreen(from, Vector{X: 0, Y: 1}, 10)))
+	down := from.Distance(rest(downhill.RollOnGreen(from, Vector{X: 0, Y: 1}, 10)))
+	if up >= down {
+		t.Errorf("uphill putt should come up shorter than downhill: uphill %v, downhill %v", up, down)
+	}
+}
+
+func TestHole_DetectHoleOutOnPath(t *testing.T) {
+	hole := puttingGreen(Vector{}, DefaultStimp)
+	cup := hole.HoleLocation
+
+	through := []Point{{X: cup.X - 20, Y: cup.Y - 40}, {X: cup.X - 5, Y: cup.Y - 10}, {X: cup.X, Y: cup.Y}, {X: cup.X, Y: cup.Y + 10}}
+	if !hole.DetectHoleOutOnPath(through) {
+		t.Error("a curling putt that runs over the cup at pace should drop")
+	}
+
+	wide := []Point{{X: cup.X - 20, Y: cup.Y - 40}, {X: cup.X - 20, Y: cup.Y}, {X: cup.X - 15, Y: cup.Y + 10}}
+	if hole.DetectHoleOutOnPath(wide) {
+		t.Error("a putt that misses the cup should not drop")
+	}
+
+	tooFast := []Point{{X: cup.X, Y: cup.Y - 40}, {X: cup.X, Y: cup.Y}, {X: cup.X, Y: cup.Y + 100}}
+	if hole.DetectHoleOutOnPath(tooFast) {
+		t.Error("a putt that races well past the cup should not drop")
+	}
+}
+
+func TestHole_ReadPutt(t *testing.T) {
+	from := yards(20, 20)
+
+	flat := puttingGreen(Vector{}, DefaultStimp).ReadPutt(from)
+	if math.Abs(float64(flat.Break)) > 0.01 || flat.Grade != 0 || flat.Stimp != DefaultStimp {
+		t.Errorf("flat read = %+v, want no break at the default stimp", flat)
+	}
+
+	right := puttingGreen(Vector{X: 2, Y: 0}, DefaultStimp).ReadPutt(from)
+	if right.Break <= 0 {
+		t.Errorf("green falling right should read as breaking right, got %+v", right)
+	}
+
+	uphill := puttingGreen(Vector{X: 0, Y: -1.5}, 11).ReadPutt(from)
+	if uphill.Grade < 1.4 || uphill.Grade > 1.6 {
+		t.Errorf("uphill grade = %.2f, want 1.5", uphill.Grade)
+	}
+	if uphill.Stimp != 11 {
+		t.Errorf("stimp = %v, want 11", uphill.Stimp)
+	}
+}
+
+func TestHole_AimPointsPlayTheBreak(t *testing.T) {
+	from := yards(20, 20)
+
+	for _, aim := range puttingGreen(Vector{}, DefaultStimp).AimPoints(from, 40) {
+		if aim.Name == AimPlayTheBreak {
+			t.Error("a straight putt should not offer to play the break")
+		}
+	}
+
+	hole := puttingGreen(Vector{X: 2, Y: 0}, DefaultStimp)
+	aims := hole.AimPoints(from, 40)
+	byName := map[string]Aim{}
+	for _, aim := range aims {
+		byName[aim.Name] = aim
+	}
+	aim, ok := byName[AimPlayTheBreak]
+	if !ok {
+		t.Fatalf("a breaking putt should offer to play the break, got %+v", aims)
+	}
+	if aim.Target.X >= hole.HoleLocation.X {
+		t.Errorf("a putt breaking right should be aimed left of the hole, aimed at %+v", aim.Target)
+	}
+
+	straight := hole.RollOnGreen(from, from.Direction(hole.HoleLocation), 10)
+	played := hole.RollOnGreen(from, aim.Direction(from), 10)
+	if rest(played).Distance(hole.HoleLocation) >= rest(straight).Distance(hole.HoleLocation) {
+		t.Errorf("playing the break should finish nearer the hole: played %+v, straight %+v", rest(played), rest(straight))
+	}
+}
+
+func TestFlight_DetectHoleOutFollowsRollPath(t *testing.T) {
+	hole := puttingGreen(Vector{}, DefaultStimp)
+	cup := hole.HoleLocation
+	start := Point{X: cup.X - 20, Y: cup.Y - 40}
+
+	// a straight line from start to rest misses, but the roll curled over the cup
+	flight := Flight{
+		Origin:   start,
+		Landing:  start,
+		Rest:     Point{X: cup.X, Y: cup.Y + 10},
+		RollPath: []Point{start, {X: cup.X, Y: cup.Y - 5}, {X: cup.X, Y: cup.Y + 10}},
+	}
+	if !hole.DetectHoleOutOnFlight(flight) {
+		t.Error("expected the curling roll to find the cup")
+	}
+}
@@ -2,8 +2,10 @@ package gogolf
 
 // GridCell represents a single cell in the course grid
 type GridCell struct {
-	Position Point   // Center position of the cell
-	Lie      LieType // The lie type for this cell
+	Position  Point   // Center position of the cell
+	Lie       LieType // The lie type for this cell
+	Slope     Vector  // Downhill direction, magnitude is percent grade (greens only)
+	Elevation Foot    // Height of the ground in feet
 }
 
 // CourseGrid represents a spatial grid of the golf course
@@ -13,6 +15,7 @@ type CourseGrid struct {
 	Width    Yard         // Total width of the grid
 	Length   Yard         // Total length of the grid
 	CellSize Yard         // Size of each grid cell (square)
+	Stimp    float64      // Green speed; higher rolls further
 }
 
 // NewCourseGrid creates a new course grid with the specified dimensions
@@ -42,6 +45,7 @@ func NewCourseGrid(width, length, cellSize Yard) CourseGrid {
 		Width:    width,
 		Length:   length,
 		CellSize: cellSize,
+		Stimp:    DefaultStimp,
 	}
 }
 
@@ -0,0 +1,182 @@
+package gogolf
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// StandardSlope is the slope rating of a course of average difficulty for a bogey golfer
+const StandardSlope = 113
+
+// Limits on course ratings and handicaps, as in the World Handicap System
+const (
+	MinimumSlope = 55
+	MaximumSlope = 155
+	// MaximumHandicapIndex is the highest index a golfer can hold
+	MaximumHandicapIndex = 54.0
+	// handicapRecordSize is how many of the most recent scores the index is worked out from
+	handicapRecordSize = 20
+	// minimumScoresForIndex is how many scores a golfer must post before they have an index
+	minimumScoresForIndex = 3
+	// unhandicappedMaximum is the most over par a hole counts for before a golfer has an index
+	unhandicappedMaximum = 5
+)
+
+// PostedScore is a completed round entered in a golfer's scoring record:
+// what they shot and how hard the course was rated
+type PostedScore struct {
+	Course string `json:"course"`
+	Holes  int    `json:"holes"`
+	Par    int    `json:"par"`
+	Gross  int    `json:"gross"`
+	// AdjustedGross caps each hole at net double bogey so one disaster doesn't distort the index
+	AdjustedGross int     `json:"adjusted_gross"`
+	Rating        float64 `json:"rating"`
+	Slope         int     `json:"slope"`
+}
+
+// NewPostedScore turns a completed scorecard into a score for the golfer's record. Holes are capped
+// at net double bogey for the course handicap on the card, or at par plus five for a golfer who
+// has no index yet. A hole that was picked up counts at its most likely score, one more than the
+// strokes taken and never under par, under the same cap.
+func NewPostedScore(card ScoreCard, hasIndex bool) (PostedScore, error) {
+	score := PostedScore{
+		Course: card.Course.Name,
+		Holes:  len(card.Course.Holes),
+		Par:    card.Course.Par(),
+		Rating: card.Course.CourseRating(),
+		Slope:  card.Course.SlopeRating(),
+	}
+	for _, hole := range card.Course.Holes {
+		strokes, played := card.Scores[hole.Number]
+		pickedUp := card.IsPickedUp(hole)
+		if !pickedUp && (!played || strokes == 0) {
+			return PostedScore{}, fmt.Errorf("hole %d has no score", hole.Number)
+		}
+		limit := hole.Par + unhandicappedMaximum
+		if hasIndex {
+			limit = hole.Par + 2 + card.StrokesReceived(hole)
+		}
+		if pickedUp {
+			strokes = min(max(strokes+1, hole.Par), limit)
+		}
+		score.Gross += strokes
+		score.AdjustedGross += min(strokes, limit)
+	}
+	return score, nil
+}
+
+// Differential is how the round compares with the course rating, adjusted to a course of standard
+// slope and scaled up to eighteen holes
+func (s PostedScore) Differential() float64 {
+	differential := float64(StandardSlope) / float64(s.Slope) * (float64(s.AdjustedGross) - s.Rating)
+	return math.Round(differential*18/float64(s.Holes)*10) / 10
+}
+
+// indexCalculation is how many of the lowest differentials count towards the index and the
+// adjustment made to their average, for a record of a given number of scores
+func indexCalculation(scores int) (count int, adjustment float64) {
+	switch {
+	case scores <= 3:
+		return 1, -2
+	case scores == 4:
+		return 1, -1
+	case scores == 5:
+		return 1, 0
+	case scores == 6:
+		return 2, -1
+	case scores <= 8:
+		return 2, 0
+	case scores <= 11:
+		return 3, 0
+	case scores <= 14:
+		return 4, 0
+	case scores <= 16:
+		return 5, 0
+	case scores <= 18:
+		return 6, 0
+	case scores == 19:
+		return 7, 0
+	default:
+		return 8, 0
+	}
+}
+
+// HandicapIndex averages the best differentials from the most recent scores in record, which is
+// oldest first. It reports false until enough scores have been posted.
+func HandicapIndex(record []PostedScore) (float64, bool) {
+	if len(record) < minimumScoresForIndex {
+		return 0, false
+	}
+	if len(record) > handicapRecordSize {
+		record = record[len(record)-handicapRecordSize:]
+	}
+	differentials := make([]float64, len(record))
+	for i, score := range record {
+		differentials[i] = score.Differential()
+	}
+	sort.Float64s(differentials)
+
+	count, adjustment := indexCalculation(len(record))
+	total := 0.0
+	for _, differential := range differentials[:count] {
+		total += differential
+	}
+	index := math.Round((total/float64(count)+adjustment)*10) / 10
+	return math.Min(index, MaximumHandicapIndex), true
+}
+
+// CourseHandicap is how many strokes a golfer with index receives over this course: the index
+// scaled to the course's length and slope plus the difference between its rating and par
+func (c Course) CourseHandicap(index float64) int {
+	scaled := index * float64(len(c.Holes)) / 18
+	return int(math.Round(scaled*float64(c.SlopeRating())/StandardSlope + c.CourseRating() - float64(c.Par())))
+}
+
+// CourseRating is what a scratch golfer is expected to shoot; a course without one is rated at par
+func (c Course) CourseRating() float64 {
+	if c.Rating == 0 {
+		return float64(c.Par())
+	}
+	return c.Rating
+}
+
+// SlopeRating is how much harder the course plays for a bogey golfer than for a scratch golfer;
+// a course without one has the standard slope
+func (c Course) SlopeRating() int {
+	if c.Slope == 0 {
+		return StandardSlope
+	}
+	return c.Slope
+}
+
+// StrokeIndex ranks the hole's difficulty on the course, 1 for the hardest. A hole without one
+// is ranked by where it comes in the round.
+func (c Course) StrokeIndex(h Hole) int {
+	if h.StrokeIndex > 0 {
+		return h.StrokeIndex
+	}
+	for i, hole := range c.Holes {
+		if hole.Number == h.Number {
+			return i + 1
+		}
+	}
+	return len(c.Holes)
+}
+
+// HandicapIndex is the golfer's index from their scoring record; false until they have one
+func (g Golfer) HandicapIndex() (float64, bool) {
+	return HandicapIndex(g.ScoringRecord)
+}
+
+// PostScore enters a completed round in the golfer's scoring record
+func (g *Golfer) PostScore(card ScoreCard) (PostedScore, error) {
+	_, hasIndex := g.HandicapIndex()
+	score, err := NewPostedScore(card, hasIndex)
+	if err != nil {
+		return PostedScore{}, err
+	}
+	g.ScoringRecord = append(g.ScoringRecord, score)
+	return score, nil
+}
@@ -0,0 +1,174 @@
+package gogolf
+
+import (
+	"testing"
+)
+
+// handicapCourse is an eighteen-hole par 72 with stroke index 1 on the first hole, 2 on the second and so on
+func handicapCourse(rating float64, slope int) Course {
+	course := Course{Name: "Test", Rating: rating, Slope: slope}
+	for i := 1; i <= 18; i++ {
+		course.Holes = append(course.Holes, Hole{Number: i, Par: 4, StrokeIndex: i})
+	}
+	return course
+}
+
+func TestPostedScoreDifferential(t *testing.T) {
+	score := PostedScore{Holes: 18, AdjustedGross: 85, Rating: 71.2, Slope: 130}
+
+	// 113/130 x (85 - 71.2) = 11.99
+	if got := score.Differential(); got != 12.0 {
+		t.Errorf("Differential = %.1f, want 12.0", got)
+	}
+
+	nine := PostedScore{Holes: 9, AdjustedGross: 42, Rating: 36, Slope: 113}
+	if got := nine.Differential(); got != 12.0 {
+		t.Errorf("a nine-hole differential should be scaled to eighteen holes, got %.1f", got)
+	}
+}
+
+func TestHandicapIndexNeedsThreeScores(t *testing.T) {
+	record := []PostedScore{
+		{Holes: 18, AdjustedGross: 90, Rating: 72, Slope: 113},
+		{Holes: 18, AdjustedGross: 85, Rating: 72, Slope: 113},
+	}
+	if _, ok := HandicapIndex(record); ok {
+		t.Fatal("two scores should not establish an index")
+	}
+
+	record = append(record, PostedScore{Holes: 18, AdjustedGross: 88, Rating: 72, Slope: 113})
+	// the lowest differential of three, 13, less two
+	if index, ok := HandicapIndex(record); !ok || index != 11.0 {
+		t.Errorf("HandicapIndex = %.1f, %v, want 11.0", index, ok)
+	}
+}
+
+func TestHandicapIndexUsesBestEightOfLastTwenty(t *testing.T) {
+	var record []PostedScore
+	// an old run of great scores that has dropped out of the last twenty
+	for range 5 {
+		record = append(record, PostedScore{Holes: 18, AdjustedGross: 72, Rating: 72, Slope: 113})
+	}
+	for i := range 20 {
+		record = append(record, PostedScore{Holes: 18, AdjustedGross: 80 + i, Rating: 72, Slope: 113})
+	}
+
+	// differentials 8 to 15 are the best eight, averaging 11.5
+	if index, _ := HandicapIndex(record); index != 11.5 {
+		t.Errorf("HandicapIndex = %.1f, want 11.5", index)
+	}
+}
+
+func TestHandicapIndexIsCapped(t *testing.T) {
+	var record []PostedScore
+	for range 3 {
+		record = append(record, PostedScore{Holes: 18, AdjustedGross: 150, Rating: 72, Slope: 113})
+	}
+	if index, _ := HandicapIndex(record); index != MaximumHandicapIndex {
+		t.Errorf("HandicapIndex = %.1f, want the maximum %.1f", index, MaximumHandicapIndex)
+	}
+}
+
+func TestCourseHandicap(t *testing.T) {
+	course := handicapCourse(73.1, 128)
+
+	// 14.2 x 128/113 + (73.1 - 72) = 17.2
+	if got := course.CourseHandicap(14.2); got != 17 {
+		t.Errorf("CourseHandicap = %d, want 17", got)
+	}
+
+	unrated := Course{Holes: course.Holes[:9]}
+	if got := unrated.CourseHandicap(14.2); got != 7 {
+		t.Errorf("nine holes at par and standard slope should give half the index, got %d", got)
+	}
+}
+
+func TestNewPostedScoreCapsAtNetDoubleBogey(t *testing.T) {
+	card := NewScoreCard(handicapCourse(72, 113))
+	card.CourseHandicap = 1
+	for _, hole := range card.Course.Holes {
+		card.Scores[hole.Number] = 4
+	}
+	card.Scores[1] = 10
+	card.Scores[2] = 10
+
+	score, err := NewPostedScore(card, true)
+	if err != nil {
+		t.Fatalf("NewPostedScore returned error: %v", err)
+	}
+	if score.Gross != 84 {
+		t.Errorf("Gross = %d, want 84", score.Gross)
+	}
+	// hole 1 receives a stroke so is capped at 7, hole 2 at 6
+	if score.AdjustedGross != 64+7+6 {
+		t.Errorf("AdjustedGross = %d, want %d", score.AdjustedGross, 64+7+6)
+	}
+
+	unestablished, _ := NewPostedScore(card, false)
+	if unestablished.AdjustedGross != 64+9+9 {
+		t.Errorf("without an index holes should be capped at par plus five, got %d", unestablished.AdjustedGross)
+	}
+}
+
+func TestNewPostedScoreCountsPickUpsAtTheirMostLikelyScore(t *testing.T) {
+	card := NewScoreCard(handicapCourse(72, 113))
+	for _, hole := range card.Course.Holes {
+		card.Scores[hole.Number] = 4
+	}
+	// picked up after two strokes, after five and before a stroke was taken
+	card.Scores[1] = 2
+	card.Scores[2] = 5
+	delete(card.Scores, 3)
+	for _, number := range []int{1, 2, 3} {
+		card.RecordPickUp(card.Course.Holes[number-1])
+	}
+
+	score, err := NewPostedScore(card, true)
+	if err != nil {
+		t.Fatalf("NewPostedScore returned error: %v", err)
+	}
+	// hole 1 counts at par, hole 2 at the net double bogey cap of 6 and hole 3 at par
+	if score.AdjustedGross != 60+4+6+4 {
+		t.Errorf("AdjustedGross = %d, want %d", score.AdjustedGross, 60+4+6+4)
+	}
+}
+
+func TestNewPostedScoreNeedsEveryHole(t *testing.T) {
+	card := NewScoreCard(handicapCourse(72, 113))
+	card.Scores[1] = 4
+	if _, err := NewPostedScore(card, false); err == nil {
+		t.Error("expected an error posting an unfinished round")
+	}
+}
+
+func TestGolferPostScore(t *testing.T) {
+	golfer := NewGolfer("Player")
+	card := NewScoreCard(handicapCourse(72, 113))
+	for _, hole := range card.Course.Holes {
+		card.Scores[hole.Number] = 5
+	}
+
+	for range 3 {
+		if _, err := golfer.PostScore(card); err != nil {
+			t.Fatalf("PostScore returned error: %v", err)
+		}
+	}
+
+	if len(golfer.ScoringRecord) != 3 {
+		t.Fatalf("expected 3 posted scores, got %d", len(golfer.ScoringRecord))
+	}
+	if index, ok := golfer.HandicapIndex(); !ok || index != 16.0 {
+		t.Errorf("HandicapIndex = %.1f, %v, want 16.0", index, ok)
+	}
+}
+
+func TestCourseStrokeIndexDefaultsToHoleOrder(t *testing.T) {
+	course := Course{Holes: []Hole{{Number: 1, Par: 4}, {Number: 2, Par: 3, StrokeIndex: 1}, {Number: 3, Par: 5}}}
+
+	if got := course.StrokeIndex(course.Holes[1]); got != 1 {
+		t.Errorf("StrokeIndex = %d, want the hole's own 1", got)
+	}
+	if got := course.StrokeIndex(course.Holes[2]); got != 3 {
+		t.Errorf("an unrated hole should be ranked by its place in the round, got %d", got)
+	}
+}
@@ -0,0 +1,337 @@
+package gogolf
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+const CurrentHistoryVersion = 1
+
+// historyFile is where the round history is kept inside the save directory
+const historyFile = "round_history.json"
+
+// HoleRecord is one hole of a finished round as the history keeps it
+type HoleRecord struct {
+	Number    int `json:"number"`
+	Par       int `json:"par"`
+	Strokes   int `json:"strokes"`
+	Penalties int `json:"penalties,omitempty"`
+	// PickedUp is a hole abandoned without holing out, so Strokes is not a true score
+	PickedUp bool         `json:"picked_up,omitempty"`
+	Shots    []ShotRecord `json:"shots"`
+}
+
+// EquipmentRecord names what the golfer carried for a round
+type EquipmentRecord struct {
+	Clubs []string `json:"clubs"`
+	Ball  string   `json:"ball,omitempty"`
+	Glove string   `json:"glove,omitempty"`
+	Shoes string   `json:"shoes,omitempty"`
+}
+
+// NewEquipmentRecord names the golfer's clubs and any ball, glove and shoes they have bought
+func NewEquipmentRecord(golfer Golfer) EquipmentRecord {
+	var record EquipmentRecord
+	for _, club := range golfer.Clubs {
+		record.Clubs = append(record.Clubs, club.Name)
+	}
+	if golfer.Ball != nil {
+		record.Ball = golfer.Ball.Name
+	}
+	if golfer.Glove != nil {
+		record.Glove = golfer.Glove.Name
+	}
+	if golfer.Shoes != nil {
+		record.Shoes = golfer.Shoes.Name
+	}
+	return record
+}
+
+// RoundRecord is a finished round kept in the history: who played it, where, when and in what
+// format, with the card hole by hole, every shot and the equipment used
+type RoundRecord struct {
+	Golfer    string          `json:"golfer"`
+	Course    string          `json:"course"`
+	PlayedAt  time.Time       `json:"played_at"`
+	Format    string          `json:"format"`
+	HoleCount int             `json:"hole_count"`
+	Holes     []HoleRecord    `json:"holes"`
+	Equipment EquipmentRecord `json:"equipment"`
+}
+
+// NewRoundRecord takes the holes played on the golfer's card; a round that finished early,
+// such as a match won before the last hole, keeps only the holes it reached. Picked-up holes
+// are kept with the strokes taken and flagged.
+func NewRoundRecord(golfer Golfer, card ScoreCard, format string, playedAt time.Time) RoundRecord {
+	round := RoundRecord{
+		Golfer:    golfer.Name,
+		Course:    card.Course.Name,
+		PlayedAt:  playedAt,
+		Format:    format,
+		HoleCount: len(card.Course.Holes),
+		Equipment: NewEquipmentRecord(golfer),
+	}
+	for _, hole := range card.Course.Holes {
+		strokes := card.TotalStrokesThisHole(hole)
+		pickedUp := card.IsPickedUp(hole)
+		if strokes == 0 && !pickedUp {
+			continue
+		}
+		round.Holes = append(round.Holes, HoleRecord{
+			Number:    hole.Number,
+			Par:       hole.Par,
+			Strokes:   strokes,
+			Penalties: card.PenaltiesThisHole(hole),
+			PickedUp:  pickedUp,
+			Shots:     card.ShotsThisHole(hole),
+		})
+	}
+	return round
+}
+
+// Complete reports whether every hole of the course was played out; a round with a picked-up
+// hole has no true total
+func (r RoundRecord) Complete() bool {
+	return r.HoleCount > 0 && len(r.Holes) == r.HoleCount && r.PickUps() == 0
+}
+
+// PickUps counts the holes abandoned without holing out
+func (r RoundRecord) PickUps() (pickUps int) {
+	for _, hole := range r.Holes {
+		if hole.PickedUp {
+			pickUps++
+		}
+	}
+	return
+}
+
+// Par is the par of the holes played
+func (r RoundRecord) Par() (par int) {
+	for _, hole := range r.Holes {
+		par += hole.Par
+	}
+	return
+}
+
+// Strokes is the total taken over the holes played
+func (r RoundRecord) Strokes() (strokes int) {
+	for _, hole := range r.Holes {
+		strokes += hole.Strokes
+	}
+	return
+}
+
+// ToPar is the score relative to par over the holes played
+func (r RoundRecord) ToPar() int {
+	return r.Strokes() - r.Par()
+}
+
+// RoundHistory is every round kept, oldest first
+type RoundHistory []RoundRecord
+
+// ForGolfer is the rounds played by the named golfer
+func (h RoundHistory) ForGolfer(name string) RoundHistory {
+	return h.filter(func(r RoundRecord) bool { return r.Golfer == name })
+}
+
+// ForCourse is the rounds played on the named course
+func (h RoundHistory) ForCourse(course string) RoundHistory {
+	return h.filter(func(r RoundRecord) bool { return r.Course == course })
+}
+
+// complete leaves out rounds that stopped short, so totals compare like with like
+func (h RoundHistory) complete() RoundHistory {
+	return h.filter(RoundRecord.Complete)
+}
+
+func (h RoundHistory) filter(keep func(RoundRecord) bool) RoundHistory {
+	var kept RoundHistory
+	for _, round := range h {
+		if keep(round) {
+			kept = append(kept, round)
+		}
+	}
+	return kept
+}
+
+// Best is up to n complete rounds with the lowest scores to par, the earlier round first on a tie
+func (h RoundHistory) Best(n int) []RoundRecord {
+	best := h.complete()
+	sort.SliceStable(best, func(i, j int) bool {
+		if best[i].ToPar() != best[j].ToPar() {
+			return best[i].ToPar() < best[j].ToPar()
+		}
+		return best[i].PlayedAt.Before(best[j].PlayedAt)
+	})
+	if len(best) > n {
+		best = best[:n]
+	}
+	return best
+}
+
+// CourseAverage is how a course has been scored over every complete round on it
+type CourseAverage struct {
+	Course       string
+	Rounds       int
+	Par          int
+	Average      float64
+	AverageToPar float64
+	Best         int
+}
+
+// CourseAverages lists the scoring on each course played, by course name
+func (h RoundHistory) CourseAverages() []CourseAverage {
+	byCourse := map[string]*CourseAverage{}
+	var names []string
+	for _, round := range h.complete() {
+		average, ok := byCourse[round.Course]
+		if !ok {
+			average = &CourseAverage{Course: round.Course, Par: round.Par(), Best: round.Strokes()}
+			byCourse[round.Course] = average
+			names = append(names, round.Course)
+		}
+		average.Rounds++
+		average.Average += float64(round.Strokes())
+		average.AverageToPar += float64(round.ToPar())
+		average.Best = min(average.Best, round.Strokes())
+	}
+	sort.Strings(names)
+
+	averages := make([]CourseAverage, 0, len(names))
+	for _, name := range names {
+		average := byCourse[name]
+		average.Average /= float64(average.Rounds)
+		average.AverageToPar /= float64(average.Rounds)
+		averages = append(averages, *average)
+	}
+	return averages
+}
+
+// HoleBest is the best and average score on one hole of a course
+type HoleBest struct {
+	Number  int
+	Par     int
+	Best    int
+	Average float64
+	Played  int
+}
+
+// HoleBests lists every hole of the course that has been played out, in hole order,
+// counting holes from rounds that stopped short too; picked-up holes are left out
+func (h RoundHistory) HoleBests(course string) []HoleBest {
+	byHole := map[int]*HoleBest{}
+	for _, round := range h.ForCourse(course) {
+		for _, hole := range round.Holes {
+			if hole.PickedUp {
+				continue
+			}
+			best, ok := byHole[hole.Number]
+			if !ok {
+				best = &HoleBest{Number: hole.Number, Par: hole.Par, Best: hole.Strokes}
+				byHole[hole.Number] = best
+			}
+			best.Played++
+			best.Average += float64(hole.Strokes)
+			best.Best = min(best.Best, hole.Strokes)
+		}
+	}
+
+	bests := make([]HoleBest, 0, len(byHole))
+	for _, best := range byHole {
+		best.Average /= float64(best.Played)
+		bests = append(bests, *best)
+	}
+	sort.Slice(bests, func(i, j int) bool { return bests[i].Number < bests[j].Number })
+	return bests
+}
+
+// TrendPoint is one complete round's score to par with the average of it and the rounds before
+// it in the window
+type TrendPoint struct {
+	PlayedAt time.Time
+	ToPar    int
+	Average  float64
+}
+
+// Trend follows the score to par of complete rounds in the order played, averaged over the
+// last window rounds to smooth it out
+func (h RoundHistory) Trend(window int) []TrendPoint {
+	rounds := h.complete()
+	sort.SliceStable(rounds, func(i, j int) bool { return rounds[i].PlayedAt.Before(rounds[j].PlayedAt) })
+	window = max(window, 1)
+
+	trend := make([]TrendPoint, len(rounds))
+	for i, round := range rounds {
+		from := max(i-window+1, 0)
+		total := 0
+		for _, earlier := range rounds[from : i+1] {
+			total += earlier.ToPar()
+		}
+		trend[i] = TrendPoint{PlayedAt: round.PlayedAt, ToPar: round.ToPar(), Average: float64(total) / float64(i+1-from)}
+	}
+	return trend
+}
+
+// historyData is the history file
+type historyData struct {
+	Version int          `json:"version"`
+	Rounds  RoundHistory `json:"rounds"`
+}
+
+// HistoryStore keeps every finished round in a file alongside the save slots
+type HistoryStore struct {
+	saveDir string
+}
+
+func NewHistoryStore(saveDir string) *HistoryStore {
+	return &HistoryStore{saveDir: saveDir}
+}
+
+func (hs *HistoryStore) path() string {
+	return filepath.Join(hs.saveDir, historyFile)
+}
+
+// Load reads every round kept so far; there is no history until the first round is added
+func (hs *HistoryStore) Load() (RoundHistory, error) {
+	jsonBytes, err := os.ReadFile(hs.path())
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read round history: %w", err)
+	}
+
+	var data historyData
+	if err := json.Unmarshal(jsonBytes, &data); err != nil {
+		return nil, fmt.Errorf("failed to parse round history: %w", err)
+	}
+	return data.Rounds, nil
+}
+
+// Add appends a finished round to the history
+func (hs *HistoryStore) Add(round RoundRecord) error {
+	rounds, err := hs.Load()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(hs.saveDir, 0755); err != nil {
+		return fmt.Errorf("failed to create save directory: %w", err)
+	}
+
+	data := historyData{Version: CurrentHistoryVersion, Rounds: append(rounds, round)}
+	jsonBytes, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to serialize round history: %w", err)
+	}
+
+	if err := os.WriteFile(hs.path(), jsonBytes, 0644); err != nil {
+		return fmt.Errorf("failed to write round history: %w", err)
+	}
+	return nil
+}
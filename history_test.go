@@ -0,0 +1,219 @@
+package gogolf
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+var historyStart = time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+
+// roundOn records a round on a course of par 4s with the given score on each hole, day days after historyStart
+func roundOn(course string, day int, scores ...int) RoundRecord {
+	round := RoundRecord{Golfer: "Tester", Course: course, PlayedAt: historyStart.AddDate(0, 0, day), Format: "Stroke Play", HoleCount: 3}
+	for i, strokes := range scores {
+		round.Holes = append(round.Holes, HoleRecord{Number: i + 1, Par: 4, Strokes: strokes})
+	}
+	return round
+}
+
+func TestNewRoundRecord(t *testing.T) {
+	course := Course{Name: "Links", Holes: []Hole{{Number: 1, Par: 4}, {Number: 2, Par: 3}}}
+	card := NewScoreCard(course)
+	playHole(&card, course.Holes[0],
+		ShotRecord{Club: "Driver", StartLie: Tee, EndLie: Rough, FromHole: 400, ToHole: 200, Penalties: 1},
+		ShotRecord{Club: "5 Iron", StartLie: Rough, EndLie: Green, FromHole: 200, ToHole: 3},
+		ShotRecord{Club: "Putter", StartLie: Green, EndLie: Green, FromHole: 3, Putt: true, HoledOut: true},
+	)
+	golfer := NewGolfer("Tester")
+	golfer.Ball = &Ball{Name: "Pro V1"}
+
+	round := NewRoundRecord(golfer, card, "Stroke Play", historyStart)
+
+	if round.Golfer != "Tester" || round.Course != "Links" || round.Format != "Stroke Play" || !round.PlayedAt.Equal(historyStart) {
+		t.Errorf("expected the round's details, got %+v", round)
+	}
+	if len(round.Holes) != 1 || round.Complete() {
+		t.Fatalf("only the first hole was played, got %+v", round.Holes)
+	}
+	if hole := round.Holes[0]; hole.Strokes != 4 || hole.Penalties != 1 || len(hole.Shots) != 3 {
+		t.Errorf("expected 4 strokes with a penalty over 3 shots, got %+v", hole)
+	}
+	if round.ToPar() != 0 {
+		t.Errorf("expected level par over the holes played, got %+d", round.ToPar())
+	}
+	if round.Equipment.Ball != "Pro V1" || len(round.Equipment.Clubs) != len(golfer.Clubs) {
+		t.Errorf("expected the equipment used, got %+v", round.Equipment)
+	}
+}
+
+func TestNewRoundRecordFlagsPickUps(t *testing.T) {
+	course := Course{Name: "Links", Holes: []Hole{{Number: 1, Par: 4}, {Number: 2, Par: 3}}}
+	card := NewScoreCard(course)
+	playHole(&card, course.Holes[0], ShotRecord{Club: "Driver", StartLie: Tee, EndLie: Rough, FromHole: 400, ToHole: 200})
+	card.RecordPickUp(course.Holes[0])
+	card.RecordPickUp(course.Holes[1])
+
+	round := NewRoundRecord(NewGolfer("Tester"), card, "Match Play", historyStart)
+
+	if len(round.Holes) != 2 || !round.Holes[0].PickedUp || !round.Holes[1].PickedUp {
+		t.Fatalf("expected both holes kept and flagged as picked up, got %+v", round.Holes)
+	}
+	if round.Holes[0].Strokes != 1 || round.PickUps() != 2 {
+		t.Errorf("expected the stroke taken and 2 pick ups, got %+v", round.Holes)
+	}
+	if round.Complete() {
+		t.Error("a round with picked-up holes should not be complete")
+	}
+}
+
+func TestRoundHistoryLeavesOutPickUps(t *testing.T) {
+	pickedUp := roundOn("Links", 1, 2, 3, 3)
+	pickedUp.Holes[0].PickedUp = true
+	history := RoundHistory{roundOn("Links", 0, 4, 4, 4), pickedUp}
+
+	if best := history.Best(10); len(best) != 1 || best[0].ToPar() != 0 {
+		t.Errorf("a round with a pick up should not count among the best, got %+v", best)
+	}
+	if averages := history.CourseAverages(); len(averages) != 1 || averages[0].Rounds != 1 {
+		t.Errorf("a round with a pick up should not count towards the average, got %+v", averages)
+	}
+	bests := history.HoleBests("Links")
+	if bests[0].Best != 4 || bests[0].Played != 1 {
+		t.Errorf("a picked-up hole should not count as a best, got %+v", bests[0])
+	}
+	if bests[1].Best != 3 || bests[1].Played != 2 {
+		t.Errorf("the holes played out in that round should still count, got %+v", bests[1])
+	}
+}
+
+func TestRoundHistoryBest(t *testing.T) {
+	history := RoundHistory{
+		roundOn("Links", 0, 5, 5, 5),
+		roundOn("Links", 1, 4, 3, 3),
+		roundOn("Links", 2, 3, 3),
+		roundOn("Park", 3, 4, 4, 3),
+	}
+
+	best := history.Best(2)
+
+	if len(best) != 2 || best[0].ToPar() != -2 || best[1].Course != "Park" {
+		t.Errorf("expected the -2 and then the -1, got %+v", best)
+	}
+	if len(history.Best(10)) != 3 {
+		t.Error("a round that stopped short should not count among the best")
+	}
+}
+
+func TestRoundHistoryCourseAverages(t *testing.T) {
+	history := RoundHistory{
+		roundOn("Park", 0, 4, 4, 4),
+		roundOn("Links", 1, 5, 5, 5),
+		roundOn("Links", 2, 4, 4, 4),
+	}
+
+	averages := history.CourseAverages()
+
+	if len(averages) != 2 || averages[0].Course != "Links" {
+		t.Fatalf("expected an average for each course by name, got %+v", averages)
+	}
+	links := averages[0]
+	if links.Rounds != 2 || links.Average != 13.5 || links.AverageToPar != 1.5 || links.Best != 12 || links.Par != 12 {
+		t.Errorf("unexpected Links average %+v", links)
+	}
+}
+
+func TestRoundHistoryHoleBests(t *testing.T) {
+	history := RoundHistory{
+		roundOn("Links", 0, 5, 3, 6),
+		roundOn("Links", 1, 4, 5),
+		roundOn("Park", 2, 2, 2, 2),
+	}
+
+	bests := history.HoleBests("Links")
+
+	if len(bests) != 3 {
+		t.Fatalf("expected all three holes, got %+v", bests)
+	}
+	if bests[0].Best != 4 || bests[0].Average != 4.5 || bests[0].Played != 2 {
+		t.Errorf("unexpected first hole %+v", bests[0])
+	}
+	if bests[2].Best != 6 || bests[2].Played != 1 {
+		t.Errorf("unexpected third hole %+v", bests[2])
+	}
+}
+
+func TestRoundHistoryTrend(t *testing.T) {
+	history := RoundHistory{
+		roundOn("Links", 2, 3, 3, 3),
+		roundOn("Links", 0, 6, 6, 6),
+		roundOn("Links", 1, 5, 5, 5),
+	}
+
+	trend := history.Trend(2)
+
+	wantToPar := []int{6, 3, -3}
+	wantAverage := []float64{6, 4.5, 0}
+	for i, point := range trend {
+		if point.ToPar != wantToPar[i] || point.Average != wantAverage[i] {
+			t.Errorf("round %d: expected %+d averaging %.1f, got %+v", i+1, wantToPar[i], wantAverage[i], point)
+		}
+	}
+}
+
+func TestRoundHistoryForGolfer(t *testing.T) {
+	other := roundOn("Links", 0, 4, 4, 4)
+	other.Golfer = "Someone Else"
+	history := RoundHistory{other, roundOn("Links", 1, 4, 4, 4)}
+
+	if rounds := history.ForGolfer("Tester"); len(rounds) != 1 || rounds[0].Golfer != "Tester" {
+		t.Errorf("expected only Tester's round, got %+v", rounds)
+	}
+}
+
+func TestHistoryStoreAddAndLoad(t *testing.T) {
+	store := NewHistoryStore(filepath.Join(t.TempDir(), "saves"))
+
+	empty, err := store.Load()
+	if err != nil || len(empty) != 0 {
+		t.Fatalf("expected no history before the first round, got %v, %v", empty, err)
+	}
+
+	first := roundOn("Links", 0, 4, 5, 3)
+	first.Holes[0].Shots = []ShotRecord{{Club: "Driver", StartLie: Tee, EndLie: Fairway, FromHole: 400, ToHole: 150, Outcome: Good}}
+	for _, round := range []RoundRecord{first, roundOn("Park", 1, 4, 4, 4)} {
+		if err := store.Add(round); err != nil {
+			t.Fatalf("Add failed: %v", err)
+		}
+	}
+
+	rounds, err := store.Load()
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if len(rounds) != 2 || rounds[1].Course != "Park" {
+		t.Fatalf("expected both rounds in order, got %+v", rounds)
+	}
+	if shot := rounds[0].Holes[0].Shots[0]; shot != first.Holes[0].Shots[0] {
+		t.Errorf("expected the shot log back, got %+v", shot)
+	}
+	if !rounds[0].PlayedAt.Equal(historyStart) {
+		t.Errorf("expected the date back, got %v", rounds[0].PlayedAt)
+	}
+}
+
+func TestHistoryStoreRejectsCorruptFile(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, historyFile), []byte("not json"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	store := NewHistoryStore(dir)
+
+	if _, err := store.Load(); err == nil {
+		t.Error("expected an error reading a corrupt history")
+	}
+	if err := store.Add(roundOn("Links", 0, 4, 4, 4)); err == nil {
+		t.Error("adding to a corrupt history should fail rather than overwrite it")
+	}
+}
@@ -0,0 +1,138 @@
+package gogolf
+
+import "fmt"
+
+// HalvedHole is recorded as the winner of a hole neither side won
+const HalvedHole = -1
+
+// Match keeps the score of a match play contest between two sides, hole by hole
+type Match struct {
+	Sides [2]string
+	Holes int
+	// Winners records the side that won each hole played so far, or HalvedHole
+	Winners []int
+}
+
+// NewMatch starts a match between two sides over the given number of holes
+func NewMatch(first, second string, holes int) Match {
+	return Match{Sides: [2]string{first, second}, Holes: holes}
+}
+
+// RecordHole scores a hole from each side's strokes: the lower score wins and a tie halves it.
+// It returns the winning side, or HalvedHole.
+func (m *Match) RecordHole(firstStrokes, secondStrokes int) (int, error) {
+	winner := HalvedHole
+	switch {
+	case firstStrokes < secondStrokes:
+		winner = 0
+	case secondStrokes < firstStrokes:
+		winner = 1
+	}
+	return winner, m.record(winner)
+}
+
+// ConcedeHole gives the hole to the side that did not concede it
+func (m *Match) ConcedeHole(side int) error {
+	if side != 0 && side != 1 {
+		return fmt.Errorf("invalid side %d: a match has sides 0 and 1", side)
+	}
+	return m.record(1 - side)
+}
+
+func (m *Match) record(winner int) error {
+	if m.IsOver() {
+		return fmt.Errorf("the match is over: %s", m.Result())
+	}
+	m.Winners = append(m.Winners, winner)
+	return nil
+}
+
+// Lead is how many holes the first side is up; it is negative when the second side leads
+func (m Match) Lead() int {
+	lead := 0
+	for _, winner := range m.Winners {
+		switch winner {
+		case 0:
+			lead++
+		case 1:
+			lead--
+		}
+	}
+	return lead
+}
+
+// Leader is the side that is up, or HalvedHole when the match is all square
+func (m Match) Leader() int {
+	switch lead := m.Lead(); {
+	case lead > 0:
+		return 0
+	case lead < 0:
+		return 1
+	default:
+		return HalvedHole
+	}
+}
+
+func (m Match) HolesPlayed() int {
+	return len(m.Winners)
+}
+
+func (m Match) HolesToPlay() int {
+	return m.Holes - m.HolesPlayed()
+}
+
+// IsDormie reports whether the leading side is up by exactly the number of holes left,
+// so the trailing side must win every one of them to halve the match
+func (m Match) IsDormie() bool {
+	lead := absInt(m.Lead())
+	return lead > 0 && lead == m.HolesToPlay()
+}
+
+// IsOver reports whether the match is decided: every hole has been played,
+// or one side is up by more holes than are left to play
+func (m Match) IsOver() bool {
+	return m.HolesToPlay() <= 0 || absInt(m.Lead()) > m.HolesToPlay()
+}
+
+// Status describes the state of the match, e.g. "Alice 2 UP with 3 to play"
+func (m Match) Status() string {
+	if m.IsOver() {
+		return m.Result()
+	}
+	leader := m.Leader()
+	if leader == HalvedHole {
+		if m.HolesPlayed() == 0 {
+			return "All square"
+		}
+		return fmt.Sprintf("All square with %d to play", m.HolesToPlay())
+	}
+	status := fmt.Sprintf("%s %d UP with %d to play", m.Sides[leader], absInt(m.Lead()), m.HolesToPlay())
+	if m.IsDormie() {
+		status += " (dormie)"
+	}
+	return status
+}
+
+// Result describes how the match finished, e.g. "Alice wins 3 & 2", "Bob wins 1 UP" or "Match halved".
+// A match still being played reports its status.
+func (m Match) Result() string {
+	if !m.IsOver() {
+		return m.Status()
+	}
+	leader := m.Leader()
+	switch {
+	case leader == HalvedHole:
+		return "Match halved"
+	case m.HolesToPlay() > 0:
+		return fmt.Sprintf("%s wins %d & %d", m.Sides[leader], absInt(m.Lead()), m.HolesToPlay())
+	default:
+		return fmt.Sprintf("%s wins %d UP", m.Sides[leader], absInt(m.Lead()))
+	}
+}
+
+func absInt(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
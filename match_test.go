@@ -0,0 +1,109 @@
+package gogolf
+
+import "testing"
+
+func TestMatch_RecordHole(t *testing.T) {
+	m := NewMatch("Alice", "Bob", 18)
+
+	tests := []struct {
+		first, second int
+		winner        int
+	}{
+		{4, 5, 0},
+		{3, 3, HalvedHole},
+		{6, 4, 1},
+	}
+	for _, tt := range tests {
+		winner, err := m.RecordHole(tt.first, tt.second)
+		if err != nil {
+			t.Fatalf("RecordHole returned error: %v", err)
+		}
+		if winner != tt.winner {
+			t.Errorf("RecordHole(%d, %d) = %d, want %d", tt.first, tt.second, winner, tt.winner)
+		}
+	}
+	if m.Lead() != 0 || m.HolesPlayed() != 3 {
+		t.Errorf("after a win, a half and a loss: lead %d, played %d", m.Lead(), m.HolesPlayed())
+	}
+}
+
+func TestMatch_Status(t *testing.T) {
+	m := NewMatch("Alice", "Bob", 9)
+	if got := m.Status(); got != "All square" {
+		t.Errorf("Status() before the first hole = %q", got)
+	}
+
+	m.RecordHole(4, 5)
+	m.RecordHole(3, 4)
+	m.RecordHole(5, 5)
+	m.RecordHole(4, 4)
+	if got := m.Status(); got != "Alice 2 UP with 5 to play" {
+		t.Errorf("Status() = %q, want %q", got, "Alice 2 UP with 5 to play")
+	}
+
+	m.RecordHole(5, 3)
+	m.RecordHole(5, 4)
+	if got := m.Status(); got != "All square with 3 to play" {
+		t.Errorf("Status() = %q, want %q", got, "All square with 3 to play")
+	}
+
+	m.RecordHole(5, 4)
+	m.RecordHole(4, 4)
+	if !m.IsDormie() {
+		t.Error("one up with one to play should be dormie")
+	}
+	if got := m.Status(); got != "Bob 1 UP with 1 to play (dormie)" {
+		t.Errorf("Status() = %q", got)
+	}
+}
+
+func TestMatch_EndsEarly(t *testing.T) {
+	m := NewMatch("Alice", "Bob", 9)
+	for range 4 {
+		m.RecordHole(4, 5)
+	}
+	if m.IsOver() {
+		t.Fatal("four up with five to play should not be over")
+	}
+
+	m.RecordHole(4, 5)
+	if !m.IsOver() {
+		t.Fatal("five up with four to play should be over")
+	}
+	if got := m.Result(); got != "Alice wins 5 & 4" {
+		t.Errorf("Result() = %q, want %q", got, "Alice wins 5 & 4")
+	}
+	if _, err := m.RecordHole(4, 5); err == nil {
+		t.Error("expected an error recording a hole after the match is over")
+	}
+}
+
+func TestMatch_ResultAfterTheLastHole(t *testing.T) {
+	won := NewMatch("Alice", "Bob", 2)
+	won.RecordHole(5, 4)
+	won.RecordHole(4, 4)
+	if got := won.Result(); got != "Bob wins 1 UP" {
+		t.Errorf("Result() = %q, want %q", got, "Bob wins 1 UP")
+	}
+
+	halved := NewMatch("Alice", "Bob", 2)
+	halved.RecordHole(5, 4)
+	halved.RecordHole(3, 4)
+	if got := halved.Result(); got != "Match halved" {
+		t.Errorf("Result() = %q, want %q", got, "Match halved")
+	}
+}
+
+func TestMatch_ConcedeHole(t *testing.T) {
+	m := NewMatch("Alice", "Bob", 18)
+
+	if err := m.ConcedeHole(0); err != nil {
+		t.Fatalf("ConcedeHole returned error: %v", err)
+	}
+	if m.Leader() != 1 {
+		t.Errorf("Alice conceding should put Bob up, leader = %d", m.Leader())
+	}
+	if err := m.ConcedeHole(2); err == nil {
+		t.Error("expected an error conceding for a side that does not exist")
+	}
+}
@@ -0,0 +1,249 @@
+package gogolf
+
+import (
+	"fmt"
+	"math"
+)
+
+type ObstacleKind int
+
+const (
+	Tree ObstacleKind = iota
+	TreeLine
+	OutOfBoundsStake
+)
+
+func (k ObstacleKind) String() string {
+	return [...]string{
+		"Tree",
+		"Tree Line",
+		"OB Stake",
+	}[k]
+}
+
+// ParseObstacleKind converts a name such as "tree" or "tree_line" into an ObstacleKind
+func ParseObstacleKind(name string) (ObstacleKind, error) {
+	normalized := normalizeName(name)
+	for kind := Tree; kind <= OutOfBoundsStake; kind++ {
+		if normalizeName(kind.String()) == normalized {
+			return kind, nil
+		}
+	}
+	return Tree, fmt.Errorf("unknown obstacle %q", name)
+}
+
+// Obstacle is a tall hazard standing on a hole. Its footprint is the segment from Start to End
+// widened by Radius on every side, so a lone tree has Start == End and a tree line runs between them.
+type Obstacle struct {
+	Kind   ObstacleKind
+	Name   string
+	Start  Point
+	End    Point
+	Radius Unit
+	Height Foot
+}
+
+// Contains reports whether a point on the ground lies within the obstacle's footprint
+func (o Obstacle) Contains(p Point) bool {
+	return p.DistanceToSegment(o.Start, o.End) <= o.Radius
+}
+
+// UnderCover reports whether a ball at pos lies beneath a tree or tree line
+func (h Hole) UnderCover(pos Point) bool {
+	for _, obstacle := range h.Obstacles {
+		if obstacle.Kind != OutOfBoundsStake && obstacle.Contains(pos) {
+			return true
+		}
+	}
+	return false
+}
+
+// trunkShare is the part of an obstacle's height where a ball hits something solid and
+// bounces off rather than dropping through branches
+const trunkShare = 0.3
+
+type DeflectionOutcome int
+
+const (
+	Ricochet DeflectionOutcome = iota
+	DroppedDown
+)
+
+func (d DeflectionOutcome) String() string {
+	return [...]string{
+		"Ricochet",
+		"Dropped Down",
+	}[d]
+}
+
+// ObstacleCollision records a ball striking an obstacle and where it finished as a result
+type ObstacleCollision struct {
+	Obstacle Obstacle
+	Contact  Point
+	Height   Foot
+	Outcome  DeflectionOutcome
+	Rest     Point
+}
+
+// BallHeight returns the height of a ball on a parabolic flight with the given apex,
+// progress being 0 at the start of the segment and 1 where it lands
+func BallHeight(apex Foot, progress float64) Foot {
+	return Foot(4 * float64(apex) * progress * (1 - progress))
+}
+
+// CheckObstacles follows the ball from one point to another and returns the first obstacle
+// it runs into below that obstacle's height, or nil if the path is clear. A rolling ball has an apex of 0.
+// A ball that catches the lower part of an obstacle ricochets away; one that clips the top drops straight down.
+func (h Hole) CheckObstacles(from, to Point, apex Foot, random RandomSource) *ObstacleCollision {
+	if len(h.Obstacles) == 0 {
+		return nil
+	}
+
+	path := from.Direction(to)
+	length := path.Magnitude()
+	if length == 0 {
+		return nil
+	}
+
+	var hit *Obstacle
+	first := math.Inf(1)
+	for i, obstacle := range h.Obstacles {
+		if obstacle.Contains(from) {
+			// playing out from under an obstacle does not hit it
+			continue
+		}
+		if progress, ok := obstacle.firstContact(from, to, apex); ok && progress < first {
+			hit, first = &h.Obstacles[i], progress
+		}
+	}
+	if hit == nil {
+		return nil
+	}
+
+	collision := &ObstacleCollision{Obstacle: *hit, Contact: from.Move(path, first*length), Height: BallHeight(apex, first)}
+	collision.resolve(path, length*(1-first), random)
+	return collision
+}
+
+// firstContact returns how far along the path from one point to another, from 0 to 1, the ball
+// first meets the obstacle below its height. The distance from a point moving along a straight
+// line to the obstacle's segment only falls and then rises, so the path crosses the footprint at
+// most once and the crossing is found exactly, however narrow the obstacle.
+func (o Obstacle) firstContact(from, to Point, apex Foot) (float64, bool) {
+	start, end := o.Start.Vector(), o.End.Vector()
+	distance := func(progress float64) float64 {
+		position := Vector{
+			X: float64(from.X) + progress*float64(to.X-from.X),
+			Y: float64(from.Y) + progress*float64(to.Y-from.Y),
+		}
+		return distanceToSegment(position, start, end)
+	}
+	radius := float64(o.Radius)
+
+	// the closest approach, by ternary search over the falling-then-rising distance
+	low, high := 0.0, 1.0
+	for range 100 {
+		a, b := low+(high-low)/3, high-(high-low)/3
+		if distance(a) < distance(b) {
+			high = b
+		} else {
+			low = a
+		}
+	}
+	closest := (low + high) / 2
+	if distance(closest) > radius {
+		return 0, false
+	}
+
+	// where the path enters and leaves the footprint, by bisection either side of the closest approach
+	enter, exit := 0.0, 1.0
+	if distance(0) > radius {
+		outside, inside := 0.0, closest
+		for range 100 {
+			mid := (outside + inside) / 2
+			if distance(mid) > radius {
+				outside = mid
+			} else {
+				inside = mid
+			}
+		}
+		enter = inside
+	}
+	if distance(1) > radius {
+		inside, outside := closest, 1.0
+		for range 100 {
+			mid := (inside + outside) / 2
+			if distance(mid) > radius {
+				outside = mid
+			} else {
+				inside = mid
+			}
+		}
+		exit = inside
+	}
+
+	if BallHeight(apex, enter) < o.Height {
+		return enter, true
+	}
+	// the ball passes over the obstacle at the point it enters; it can still come down into it
+	// once its height falls back below the obstacle's on the way down
+	descent := (1 + math.Sqrt(1-float64(o.Height)/float64(apex))) / 2
+	if descent > enter && descent <= exit {
+		return descent, true
+	}
+	return 0, false
+}
+
+// distanceToSegment is how far p is from the nearest point on the segment from a to b
+func distanceToSegment(p, a, b Vector) float64 {
+	segment := b.Subtract(a)
+	lengthSquared := segment.Dot(segment)
+	t := 0.0
+	if lengthSquared > 0 {
+		t = math.Max(0, math.Min(1, p.Subtract(a).Dot(segment)/lengthSquared))
+	}
+	return p.Subtract(Vector{X: a.X + t*segment.X, Y: a.Y + t*segment.Y}).Magnitude()
+}
+
+// CheckObstaclesOnPath follows a rolling ball along every leg of path and returns the first
+// obstacle it runs into, or nil if the path is clear. The path it returns is the one the ball
+// really took: cut at the point of contact and finishing where the collision left it.
+func (h Hole) CheckObstaclesOnPath(path []Point, random RandomSource) (*ObstacleCollision, []Point) {
+	for i := 1; i < len(path); i++ {
+		collision := h.CheckObstacles(path[i-1], path[i], 0, random)
+		if collision == nil {
+			continue
+		}
+		rolled := append(path[:i:i], collision.Contact)
+		if collision.Rest != collision.Contact {
+			rolled = append(rolled, collision.Rest)
+		}
+		return collision, rolled
+	}
+	return nil, path
+}
+
+func (c *ObstacleCollision) resolve(path Vector, remaining float64, random RandomSource) {
+	if float64(c.Height) >= float64(c.Obstacle.Height)*trunkShare {
+		c.Outcome = DroppedDown
+		c.Rest = c.Contact
+		return
+	}
+
+	axis := c.Contact.ClosestPointOnSegment(c.Obstacle.Start, c.Obstacle.End)
+	normal := axis.Direction(c.Contact)
+	direction := path.Normalize()
+	if normal.Magnitude() == 0 {
+		normal = Vector{X: -direction.X, Y: -direction.Y}
+	}
+	normal = normal.Normalize()
+
+	reflected := Vector{
+		X: direction.X - 2*direction.Dot(normal)*normal.X,
+		Y: direction.Y - 2*direction.Dot(normal)*normal.Y,
+	}
+	bounce := remaining * (0.1 + random.Float64()*0.2)
+
+	c.Outcome = Ricochet
+	c.Rest = c.Contact.Move(reflected, bounce)
+}
@@ -0,0 +1,299 @@
+package gogolf
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+func holeWithOak() Hole {
+	return Hole{
+		Obstacles: []Obstacle{{
+			Kind:   Tree,
+			Name:   "Oak",
+			Start:  Point{X: 0, Y: 500},
+			End:    Point{X: 0, Y: 500},
+			Radius: Yard(4).Units(),
+			Height: 40,
+		}},
+	}
+}
+
+func TestParseObstacleKind(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected ObstacleKind
+	}{
+		{"tree", Tree},
+		{"tree_line", TreeLine},
+		{"Tree Line", TreeLine},
+		{"ob_stake", OutOfBoundsStake},
+	}
+
+	for _, tt := range tests {
+		kind, err := ParseObstacleKind(tt.name)
+		if err != nil || kind != tt.expected {
+			t.Errorf("ParseObstacleKind(%q) = %v, %v; want %v", tt.name, kind, err, tt.expected)
+		}
+	}
+
+	if _, err := ParseObstacleKind("boulder"); err == nil {
+		t.Error("expected error for unknown obstacle")
+	}
+}
+
+func TestObstacle_ContainsUsesFootprint(t *testing.T) {
+	treeLine := Obstacle{Kind: TreeLine, Start: Point{X: 0, Y: 0}, End: Point{X: 0, Y: 1000}, Radius: 20}
+
+	if !treeLine.Contains(Point{X: 15, Y: 500}) {
+		t.Error("expected point beside the middle of the tree line to be inside its footprint")
+	}
+	if treeLine.Contains(Point{X: 25, Y: 500}) {
+		t.Error("expected point beyond the radius to be outside the footprint")
+	}
+}
+
+func TestBallHeight(t *testing.T) {
+	if BallHeight(90, 0) != 0 || BallHeight(90, 1) != 0 {
+		t.Error("ball should be on the ground at the start and end of the carry")
+	}
+	if BallHeight(90, 0.5) != 90 {
+		t.Errorf("ball height at half way = %v, want apex 90", BallHeight(90, 0.5))
+	}
+}
+
+func TestCheckObstacles_LowShotIsBlocked(t *testing.T) {
+	hole := holeWithOak()
+	random := rand.New(rand.NewPCG(1, 2))
+
+	collision := hole.CheckObstacles(Point{X: 0, Y: 0}, Point{X: 0, Y: 1000}, 10, random)
+
+	if collision == nil {
+		t.Fatal("expected a low shot to hit the oak")
+	}
+	if collision.Obstacle.Name != "Oak" {
+		t.Errorf("collision obstacle = %q, want Oak", collision.Obstacle.Name)
+	}
+	if collision.Contact.Y > 500 {
+		t.Errorf("contact %+v should be on the near side of the tree", collision.Contact)
+	}
+}
+
+func TestCheckObstacles_HighShotClears(t *testing.T) {
+	hole := holeWithOak()
+	random := rand.New(rand.NewPCG(1, 2))
+
+	if collision := hole.CheckObstacles(Point{X: 0, Y: 0}, Point{X: 0, Y: 1000}, 120, random); collision != nil {
+		t.Errorf("expected a high shot to clear the oak, hit at height %.1f", collision.Height)
+	}
+}
+
+func TestCheckObstacles_PathAroundTreeIsClear(t *testing.T) {
+	hole := holeWithOak()
+	random := rand.New(rand.NewPCG(1, 2))
+
+	if collision := hole.CheckObstacles(Point{X: 200, Y: 0}, Point{X: 200, Y: 1000}, 10, random); collision != nil {
+		t.Error("expected a path wide of the oak to be clear")
+	}
+}
+
+func TestCheckObstacles_TrunkRicochetsAndCanopyDrops(t *testing.T) {
+	hole := holeWithOak()
+	random := rand.New(rand.NewPCG(1, 2))
+
+	rolling := hole.CheckObstacles(Point{X: 0, Y: 300}, Point{X: 0, Y: 700}, 0, random)
+	if rolling == nil || rolling.Outcome != Ricochet {
+		t.Fatalf("expected a rolling ball to ricochet off the trunk, got %+v", rolling)
+	}
+	if rolling.Rest.Y >= rolling.Contact.Y {
+		t.Errorf("ricochet should bounce back toward the player, contact %+v rest %+v", rolling.Contact, rolling.Rest)
+	}
+
+	// contact high in the tree: the ball is above a third of the tree's height where it meets the canopy
+	canopy := hole.CheckObstacles(Point{X: 0, Y: 440}, Point{X: 0, Y: 700}, 60, random)
+	if canopy == nil || canopy.Outcome != DroppedDown {
+		t.Fatalf("expected a ball caught in the branches to drop down, got %+v", canopy)
+	}
+	if canopy.Rest != canopy.Contact {
+		t.Errorf("dropped ball should rest where it hit, contact %+v rest %+v", canopy.Contact, canopy.Rest)
+	}
+}
+
+func TestCheckObstacles_NarrowStakeIsNotSteppedOver(t *testing.T) {
+	yard := float64(Yard(1).Units())
+	stake := Point{X: 0, Y: int(1.5 * yard)}
+	hole := Hole{Obstacles: []Obstacle{{
+		Kind:   OutOfBoundsStake,
+		Name:   "Stake",
+		Start:  stake,
+		End:    stake,
+		Radius: Unit(0.2 * yard),
+		Height: 3,
+	}}}
+	random := rand.New(rand.NewPCG(1, 2))
+
+	collision := hole.CheckObstacles(Point{X: 0, Y: 0}, Point{X: 0, Y: int(10 * yard)}, 0, random)
+	if collision == nil {
+		t.Fatal("expected a rolling ball to hit a stake between yard marks")
+	}
+	if got := collision.Contact.Distance(stake); got > Unit(0.2*yard)+1 {
+		t.Errorf("contact %+v should be on the edge of the stake, %.1f units from it", collision.Contact, got)
+	}
+}
+
+func TestCheckObstacles_DescendingBallComesDownInTreeLine(t *testing.T) {
+	hole := Hole{Obstacles: []Obstacle{{
+		Kind:   TreeLine,
+		Name:   "Pines",
+		Start:  Point{X: 0, Y: 600},
+		End:    Point{X: 0, Y: 1000},
+		Radius: Yard(1).Units(),
+		Height: 40,
+	}}}
+	random := rand.New(rand.NewPCG(1, 2))
+
+	collision := hole.CheckObstacles(Point{X: 0, Y: 0}, Point{X: 0, Y: 1000}, 60, random)
+	if collision == nil {
+		t.Fatal("expected the ball to come down into the tree line")
+	}
+	// above the trees where it reaches them, the ball drops back to 40 feet about four fifths of the way
+	if collision.Contact.Y < 780 || collision.Contact.Y > 800 {
+		t.Errorf("contact %+v should be where the ball falls below the treetops", collision.Contact)
+	}
+	if collision.Outcome != DroppedDown {
+		t.Errorf("a ball coming down through the branches should drop, got %s", collision.Outcome)
+	}
+}
+
+func TestCheckObstaclesOnPath_FollowsACurvingRoll(t *testing.T) {
+	hole := holeWithOak()
+	random := rand.New(rand.NewPCG(1, 2))
+	path := []Point{{X: 600, Y: 300}, {X: 0, Y: 300}, {X: 0, Y: 700}}
+
+	if collision := hole.CheckObstacles(path[0], path[len(path)-1], 0, random); collision != nil {
+		t.Fatal("expected the straight line from start to finish to miss the oak")
+	}
+	collision, rolled := hole.CheckObstaclesOnPath(path, random)
+	if collision == nil {
+		t.Fatal("expected the curving roll to run into the oak")
+	}
+	if len(rolled) < 3 || rolled[0] != path[0] || rolled[1] != path[1] || rolled[2] != collision.Contact {
+		t.Errorf("expected the path cut at the contact %+v, got %+v", collision.Contact, rolled)
+	}
+	if rolled[len(rolled)-1] != collision.Rest {
+		t.Errorf("expected the path to finish where the ball came to rest %+v, got %+v", collision.Rest, rolled)
+	}
+}
+
+func TestCheckObstaclesOnPath_NoHoleOutBeyondTheObstacle(t *testing.T) {
+	hole := holeWithOak()
+	hole.HoleLocation = Point{X: 0, Y: 800}
+	random := rand.New(rand.NewPCG(1, 2))
+	path := []Point{{X: 0, Y: 300}, {X: 0, Y: 600}, {X: 0, Y: 800}}
+
+	collision, rolled := hole.CheckObstaclesOnPath(path, random)
+	if collision == nil {
+		t.Fatal("expected the roll to run into the oak")
+	}
+	if hole.DetectHoleOutOnFlight(Flight{Landing: path[0], Rest: collision.Rest, RollPath: rolled}) {
+		t.Error("a roll stopped by the oak should not find the hole behind it")
+	}
+}
+
+func TestCheckObstaclesOnPath_ClearPathIsUnchanged(t *testing.T) {
+	hole := holeWithOak()
+	random := rand.New(rand.NewPCG(1, 2))
+	path := []Point{{X: 200, Y: 0}, {X: 200, Y: 500}, {X: 250, Y: 1000}}
+
+	collision, rolled := hole.CheckObstaclesOnPath(path, random)
+	if collision != nil {
+		t.Errorf("expected a path wide of the oak to be clear, hit at %+v", collision.Contact)
+	}
+	if len(rolled) != len(path) {
+		t.Errorf("expected the clear path back unchanged, got %+v", rolled)
+	}
+}
+
+func TestCheckObstacles_PlayingFromUnderTreeIgnoresIt(t *testing.T) {
+	hole := holeWithOak()
+	random := rand.New(rand.NewPCG(1, 2))
+
+	if collision := hole.CheckObstacles(Point{X: 0, Y: 500}, Point{X: 0, Y: 1000}, 0, random); collision != nil {
+		t.Error("expected a ball played from under the tree not to hit it")
+	}
+}
+
+func TestHole_UnderCover(t *testing.T) {
+	hole := holeWithOak()
+	hole.Obstacles = append(hole.Obstacles, Obstacle{Kind: OutOfBoundsStake, Start: Point{X: 200, Y: 0}, End: Point{X: 200, Y: 0}, Radius: 5, Height: 3})
+
+	if !hole.UnderCover(Point{X: 10, Y: 500}) {
+		t.Error("expected a ball beneath the oak to be under cover")
+	}
+	if hole.UnderCover(Point{X: 0, Y: 0}) {
+		t.Error("a ball in the open is not under cover")
+	}
+	if hole.UnderCover(Point{X: 200, Y: 0}) {
+		t.Error("an OB stake gives no cover")
+	}
+}
+
+func TestCourseDefinition_BuildsObstacles(t *testing.T) {
+	hole := validHoleDefinition()
+	hole.Obstacles = []ObstacleDefinition{
+		{Kind: "tree", Name: "Oak", Start: PointDefinition{X: 40, Y: 150}, Radius: 4, Height: 40},
+		{Kind: "tree_line", Start: PointDefinition{X: 2, Y: 50}, End: &PointDefinition{X: 2, Y: 250}, Radius: 3, Height: 60},
+	}
+	definition := CourseDefinition{Version: CurrentCourseVersion, Holes: []HoleDefinition{hole}}
+
+	course, err := definition.Build()
+	if err != nil {
+		t.Fatalf("Build returned error: %v", err)
+	}
+
+	obstacles := course.Holes[0].Obstacles
+	if len(obstacles) != 2 {
+		t.Fatalf("expected 2 obstacles, got %d", len(obstacles))
+	}
+	if obstacles[0].Start != obstacles[0].End {
+		t.Error("single tree should end where it starts")
+	}
+	if obstacles[1].Name != "Tree Line" {
+		t.Errorf("unnamed obstacle name = %q, want kind name", obstacles[1].Name)
+	}
+	if obstacles[1].Height != 60 {
+		t.Errorf("tree line height = %v, want 60 feet", obstacles[1].Height)
+	}
+}
+
+func TestCourseDefinition_ValidatesObstacles(t *testing.T) {
+	hole := validHoleDefinition()
+	hole.Obstacles = []ObstacleDefinition{{Kind: "tree", Start: PointDefinition{X: 40, Y: 150}, Radius: 4, Height: 0}}
+	definition := CourseDefinition{Version: CurrentCourseVersion, Holes: []HoleDefinition{hole}}
+
+	err := definition.Validate()
+	if err == nil || err.Error() != "hole 1: obstacles[0].height: must be positive" {
+		t.Errorf("expected obstacle height error, got %v", err)
+	}
+}
+
+func TestGenerateCourse_PlacesObstacles(t *testing.T) {
+	course := GenerateCourse(18, 11)
+
+	kinds := map[ObstacleKind]int{}
+	for _, hole := range course.Holes {
+		for _, obstacle := range hole.Obstacles {
+			kinds[obstacle.Kind]++
+		}
+		for _, obstacle := range hole.Obstacles {
+			if obstacle.Contains(hole.TeeLocation) {
+				t.Errorf("hole %d: %s stands on the tee", hole.Number, obstacle.Name)
+			}
+		}
+	}
+
+	for _, kind := range []ObstacleKind{Tree, TreeLine, OutOfBoundsStake} {
+		if kinds[kind] == 0 {
+			t.Errorf("expected generated course to include %v obstacles", kind)
+		}
+	}
+}
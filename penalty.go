@@ -0,0 +1,147 @@
+package gogolf
+
+type PenaltyKind int
+
+const (
+	NoPenalty PenaltyKind = iota
+	OutOfBounds
+	LostBall
+	InPenaltyArea
+)
+
+func (p PenaltyKind) String() string {
+	return [...]string{
+		"None",
+		"Out of Bounds",
+		"Lost Ball",
+		"Penalty Area",
+	}[p]
+}
+
+// ReliefOption is one of the ways to proceed after a ball comes to rest in a penalty area.
+// Each costs one penalty stroke.
+type ReliefOption int
+
+const (
+	BackOnTheLine ReliefOption = iota
+	LateralRelief
+	StrokeAndDistance
+)
+
+func (r ReliefOption) String() string {
+	return [...]string{
+		"Back-on-the-line",
+		"Lateral (two club lengths)",
+		"Replay from previous spot",
+	}[r]
+}
+
+// ClubLength is the length of the longest club in the bag, used to measure relief areas
+const ClubLength = Inch(45)
+
+// maxBackOnTheLine is how far back along the line relief is searched for before giving up
+const maxBackOnTheLine = Yard(150)
+
+// IsOutOfBounds reports whether a position is off the hole's grid.
+// Holes without a grid have no boundary.
+func (h Hole) IsOutOfBounds(pos Point) bool {
+	if h.Grid == nil {
+		return false
+	}
+	return !h.Grid.InBounds(pos)
+}
+
+// InBounds reports whether a position falls on a cell of the grid
+func (g CourseGrid) InBounds(pos Point) bool {
+	row, col := g.positionToIndices(pos)
+	return row >= 0 && row < len(g.Cells) && col >= 0 && len(g.Cells) > 0 && col < len(g.Cells[0])
+}
+
+// isPlayable reports whether a ball can be dropped at a position
+func (h Hole) isPlayable(pos Point) bool {
+	return !h.IsOutOfBounds(pos) && h.GetLieAtPosition(pos) != PenaltyArea
+}
+
+// PenaltyAreaEntry walks the ball's path and returns the point where it last crossed
+// from playable ground into the penalty area it finished in
+func (h Hole) PenaltyAreaEntry(from, to Point) Point {
+	path := from.Direction(to)
+	length := path.Magnitude()
+	entry := from
+	wasInPenaltyArea := h.GetLieAtPosition(from) == PenaltyArea
+	step := float64(Yard(1).Units())
+
+	for travelled := 0.0; travelled <= length; travelled += step {
+		position := from.Move(path, travelled)
+		inPenaltyArea := h.GetLieAtPosition(position) == PenaltyArea
+		if inPenaltyArea && !wasInPenaltyArea {
+			entry = position
+		}
+		wasInPenaltyArea = inPenaltyArea
+	}
+	return entry
+}
+
+// ReliefPoint returns where the ball is dropped for a relief option, or false when the option
+// has no legal spot. entry is where the ball last crossed into the penalty area and previous is
+// where the shot was played from.
+func (h Hole) ReliefPoint(option ReliefOption, entry, previous Point) (Point, bool) {
+	switch option {
+	case StrokeAndDistance:
+		return previous, true
+
+	case BackOnTheLine:
+		// keep the entry point between the hole and the drop, going back as far as needed
+		line := h.HoleLocation.Direction(entry)
+		if line.Magnitude() == 0 {
+			return Point{}, false
+		}
+		step := float64(Yard(1).Units())
+		for distance := step; distance <= float64(maxBackOnTheLine.Units()); distance += step {
+			drop := entry.Move(line, distance)
+			if h.IsOutOfBounds(drop) {
+				return Point{}, false
+			}
+			if h.isPlayable(drop) {
+				return drop, true
+			}
+		}
+		return Point{}, false
+
+	case LateralRelief:
+		// nearest playable spot within two club lengths that is no nearer the hole
+		maxRadius := float64((ClubLength * 2).Units())
+		entryToHole := entry.Distance(h.HoleLocation)
+		best, found := Point{}, false
+		bestDistance := Unit(0)
+		for radius := maxRadius / 4; radius <= maxRadius; radius += maxRadius / 4 {
+			for angle := 0.0; angle < 360; angle += 15 {
+				drop := entry.Move(Vector{X: 0, Y: 1}.Rotate(angle), radius)
+				if !h.isPlayable(drop) || drop.Distance(h.HoleLocation) < entryToHole {
+					continue
+				}
+				if distance := drop.Distance(entry); !found || distance < bestDistance {
+					best, bestDistance, found = drop, distance, true
+				}
+			}
+		}
+		return best, found
+	}
+	return Point{}, false
+}
+
+// lostBallChance is the chance that a ball finishing in a lie cannot be found
+func lostBallChance(lie LieType) float64 {
+	switch lie {
+	case DeepRough:
+		return 1.0 / 6
+	default:
+		return 0
+	}
+}
+
+// SearchForBall rolls for whether a ball resting in lie is found
+func SearchForBall(lie LieType, random RandomSource) (found bool) {
+	chance := lostBallChance(lie)
+	return chance == 0 || random.Float64() >= chance
+}
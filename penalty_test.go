@@ -0,0 +1,120 @@
+package gogolf
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+// holeWithCreek is 40 yards wide and 100 long with a creek crossing it between 50 and 60 yards
+func holeWithCreek() Hole {
+	hole := NewHoleWithGrid(1, 4, Point{X: int(Yard(20).Units()), Y: int(Yard(95).Units())}, Size{}, 40, 100, 5)
+	hole.Grid.PaintRegion(RegionDefinition{
+		Shape: RegionRectangle,
+		Min:   &PointDefinition{X: 0, Y: 50},
+		Max:   &PointDefinition{X: 40, Y: 60},
+	}, PenaltyArea)
+	return *hole
+}
+
+func yards(x, y Yard) Point {
+	return Point{X: int(x.Units()), Y: int(y.Units())}
+}
+
+func TestHole_IsOutOfBounds(t *testing.T) {
+	hole := holeWithCreek()
+
+	if hole.IsOutOfBounds(yards(20, 30)) {
+		t.Error("expected a point on the grid to be in bounds")
+	}
+	if !hole.IsOutOfBounds(yards(45, 30)) || !hole.IsOutOfBounds(Point{X: -1, Y: 10}) {
+		t.Error("expected points off the grid to be out of bounds")
+	}
+	if (Hole{}).IsOutOfBounds(yards(500, 500)) {
+		t.Error("a hole without a grid has no boundary")
+	}
+}
+
+func TestHole_PenaltyAreaEntry(t *testing.T) {
+	hole := holeWithCreek()
+
+	entry := hole.PenaltyAreaEntry(yards(20, 10), yards(20, 55))
+
+	if entry.Y < int(Yard(50).Units()) || entry.Y > int(Yard(51).Units()) {
+		t.Errorf("entry %+v should be where the path crosses the creek at 50 yards", entry)
+	}
+}
+
+func TestHole_ReliefPoint(t *testing.T) {
+	hole := holeWithCreek()
+	entry := hole.PenaltyAreaEntry(yards(20, 10), yards(20, 55))
+	previous := yards(20, 10)
+
+	replay, ok := hole.ReliefPoint(StrokeAndDistance, entry, previous)
+	if !ok || replay != previous {
+		t.Errorf("stroke and distance should replay from %+v, got %+v", previous, replay)
+	}
+
+	back, ok := hole.ReliefPoint(BackOnTheLine, entry, previous)
+	if !ok {
+		t.Fatal("expected back-on-the-line relief")
+	}
+	if hole.GetLieAtPosition(back) == PenaltyArea || back.Distance(hole.HoleLocation) <= entry.Distance(hole.HoleLocation) {
+		t.Errorf("back-on-the-line drop %+v should be playable and behind the entry point", back)
+	}
+
+	lateral, ok := hole.ReliefPoint(LateralRelief, entry, previous)
+	if !ok {
+		t.Fatal("expected lateral relief")
+	}
+	if lateral.Distance(entry) > (ClubLength * 2).Units() {
+		t.Errorf("lateral drop %+v is more than two club lengths from %+v", lateral, entry)
+	}
+	if hole.GetLieAtPosition(lateral) == PenaltyArea || lateral.Distance(hole.HoleLocation) < entry.Distance(hole.HoleLocation) {
+		t.Errorf("lateral drop %+v should be playable and no nearer the hole", lateral)
+	}
+}
+
+func TestHole_ReliefPointUnavailableWhenBoundaryIsBehind(t *testing.T) {
+	hole := holeWithCreek()
+	hole.Grid.PaintRegion(RegionDefinition{
+		Shape: RegionRectangle,
+		Min:   &PointDefinition{X: 0, Y: 0},
+		Max:   &PointDefinition{X: 40, Y: 60},
+	}, PenaltyArea)
+
+	if _, ok := hole.ReliefPoint(BackOnTheLine, yards(20, 55), yards(20, 5)); ok {
+		t.Error("expected no back-on-the-line relief when the line runs out of bounds")
+	}
+}
+
+func TestSearchForBall(t *testing.T) {
+	random := rand.New(rand.NewPCG(1, 2))
+
+	lost := 0
+	for range 600 {
+		if !SearchForBall(Fairway, random) {
+			t.Fatal("a ball on the fairway is never lost")
+		}
+		if !SearchForBall(DeepRough, random) {
+			lost++
+		}
+	}
+	if lost == 0 || lost > 200 {
+		t.Errorf("lost %d of 600 balls in deep rough, expected about 100", lost)
+	}
+}
+
+func TestScoreCard_RecordPenalty(t *testing.T) {
+	hole := Hole{Number: 1, Par: 4}
+	sc := NewScoreCard(Course{Holes: []Hole{hole}})
+
+	sc.RecordStroke(hole)
+	sc.RecordPenalty(hole)
+
+	if sc.TotalStrokesThisHole(hole) != 2 {
+		t.Errorf("expected penalty to count toward the score, got %d strokes", sc.TotalStrokesThisHole(hole))
+	}
+	if sc.PenaltiesThisHole(hole) != 1 || sc.TotalPenalties() != 1 {
+		t.Errorf("expected 1 penalty, got %d", sc.PenaltiesThisHole(hole))
+	}
+}
@@ -9,9 +9,12 @@ import (
 	"time"
 )
 
-const CurrentSaveVersion = 1
+const CurrentSaveVersion = 2
 const MaxSaveSlots = 5
 
+// suspendedRoundFile holds the one round put aside mid-play, waiting to be continued
+const suspendedRoundFile = "suspended_round.json"
+
 type SkillData struct {
 	Name       string `json:"name"`
 	Level      int    `json:"level"`
@@ -66,6 +69,58 @@ type SaveData struct {
 	Ball       *Ball                  `json:"ball,omitempty"`
 	Glove      *Glove                 `json:"glove,omitempty"`
 	Shoes      *Shoes                 `json:"shoes,omitempty"`
+	Career     *Career                `json:"career,omitempty"`
+	// ScoringRecord is the golfer's posted scores for handicap, oldest first
+	ScoringRecord []PostedScore `json:"scoring_record,omitempty"`
+	// Round is the round the golfer put aside partway through; only a suspended round's save has one
+	Round *RoundData `json:"round,omitempty"`
+}
+
+// ScoreCardData is a scorecard without its course, which is saved alongside it
+type ScoreCardData struct {
+	Scores         map[int]int          `json:"scores"`
+	Penalties      map[int]int          `json:"penalties,omitempty"`
+	PickedUp       map[int]bool         `json:"picked_up,omitempty"`
+	CourseHandicap int                  `json:"course_handicap,omitempty"`
+	Shots          map[int][]ShotRecord `json:"shots,omitempty"`
+}
+
+func NewScoreCardData(card ScoreCard) ScoreCardData {
+	return ScoreCardData{
+		Scores:         card.Scores,
+		Penalties:      card.Penalties,
+		PickedUp:       card.PickedUp,
+		CourseHandicap: card.CourseHandicap,
+		Shots:          card.Shots,
+	}
+}
+
+func (sd ScoreCardData) ToScoreCard(course Course) ScoreCard {
+	card := NewScoreCard(course)
+	if sd.Scores != nil {
+		card.Scores = sd.Scores
+	}
+	card.Penalties = sd.Penalties
+	card.PickedUp = sd.PickedUp
+	card.CourseHandicap = sd.CourseHandicap
+	card.Shots = sd.Shots
+	return card
+}
+
+// RoundData is a round in progress, saved between shots so it can carry on exactly where it stopped
+type RoundData struct {
+	// Course is the definition the course was built from, under the name it was played as
+	Course           CourseDefinition `json:"course"`
+	Scoring          string           `json:"scoring,omitempty"`
+	CurrentHole      int              `json:"current_hole"`
+	BallLocation     Point            `json:"ball_location"`
+	PreviousLocation Point            `json:"previous_location"`
+	ScoreCard        ScoreCardData    `json:"scorecard"`
+	Weather          Weather          `json:"weather"`
+	// Random is the state of the round's random source, so the rest of the round plays out as it would have
+	Random []byte `json:"random"`
+	// LastShot is the result of the shot before the round stopped, as the game records it
+	LastShot json.RawMessage `json:"last_shot,omitempty"`
 }
 
 func NewSaveData(golfer Golfer) SaveData {
@@ -89,6 +144,9 @@ func NewSaveData(golfer Golfer) SaveData {
 		Ball:       golfer.Ball,
 		Glove:      golfer.Glove,
 		Shoes:      golfer.Shoes,
+		Career:     golfer.Career,
+
+		ScoringRecord: golfer.ScoringRecord,
 	}
 }
 
@@ -107,6 +165,8 @@ func (sd SaveData) ToGolfer() Golfer {
 	golfer.Ball = sd.Ball
 	golfer.Glove = sd.Glove
 	golfer.Shoes = sd.Shoes
+	golfer.Career = sd.Career
+	golfer.ScoringRecord = sd.ScoringRecord
 
 	return golfer
 }
@@ -141,39 +201,95 @@ func (sm *SaveManager) Save(slot int, golfer Golfer) error {
 		return err
 	}
 
+	return sm.write(sm.slotPath(slot), NewSaveData(golfer))
+}
+
+func (sm *SaveManager) Load(slot int) (Golfer, error) {
+	if err := sm.validateSlot(slot); err != nil {
+		return Golfer{}, err
+	}
+
+	saveData, err := sm.read(sm.slotPath(slot))
+	if err != nil {
+		return Golfer{}, err
+	}
+
+	return saveData.ToGolfer(), nil
+}
+
+func (sm *SaveManager) write(path string, saveData SaveData) error {
 	if err := os.MkdirAll(sm.saveDir, 0755); err != nil {
 		return fmt.Errorf("failed to create save directory: %w", err)
 	}
 
-	saveData := NewSaveData(golfer)
 	jsonBytes, err := json.MarshalIndent(saveData, "", "  ")
 	if err != nil {
 		return fmt.Errorf("failed to serialize save data: %w", err)
 	}
 
-	if err := os.WriteFile(sm.slotPath(slot), jsonBytes, 0644); err != nil {
+	if err := os.WriteFile(path, jsonBytes, 0644); err != nil {
 		return fmt.Errorf("failed to write save file: %w", err)
 	}
 
 	return nil
 }
 
-func (sm *SaveManager) Load(slot int) (Golfer, error) {
-	if err := sm.validateSlot(slot); err != nil {
-		return Golfer{}, err
+func (sm *SaveManager) read(path string) (SaveData, error) {
+	jsonBytes, err := os.ReadFile(path)
+	if err != nil {
+		return SaveData{}, fmt.Errorf("failed to read save file: %w", err)
 	}
 
-	jsonBytes, err := os.ReadFile(sm.slotPath(slot))
+	jsonBytes, err = MigrateSave(jsonBytes)
 	if err != nil {
-		return Golfer{}, fmt.Errorf("failed to read save file: %w", err)
+		return SaveData{}, err
 	}
 
 	var saveData SaveData
 	if err := json.Unmarshal(jsonBytes, &saveData); err != nil {
-		return Golfer{}, fmt.Errorf("failed to parse save file: %w", err)
+		return SaveData{}, fmt.Errorf("failed to parse save file: %w", err)
 	}
 
-	return saveData.ToGolfer(), nil
+	return saveData, nil
+}
+
+func (sm *SaveManager) suspendedRoundPath() string {
+	return filepath.Join(sm.saveDir, suspendedRoundFile)
+}
+
+// SuspendRound puts the golfer's round in progress aside, replacing any round suspended before it
+func (sm *SaveManager) SuspendRound(golfer Golfer, round RoundData) error {
+	saveData := NewSaveData(golfer)
+	saveData.Round = &round
+	return sm.write(sm.suspendedRoundPath(), saveData)
+}
+
+// HasSuspendedRound reports whether there is a round waiting to be continued
+func (sm *SaveManager) HasSuspendedRound() bool {
+	_, err := os.Stat(sm.suspendedRoundPath())
+	return err == nil
+}
+
+// LoadSuspendedRound reads back the suspended round and the golfer playing it as they were when it stopped
+func (sm *SaveManager) LoadSuspendedRound() (Golfer, RoundData, error) {
+	saveData, err := sm.read(sm.suspendedRoundPath())
+	if err != nil {
+		return Golfer{}, RoundData{}, err
+	}
+	if saveData.Round == nil {
+		return Golfer{}, RoundData{}, fmt.Errorf("suspended round file has no round in it")
+	}
+
+	return saveData.ToGolfer(), *saveData.Round, nil
+}
+
+// ClearSuspendedRound removes the suspended round once it has been picked up again
+func (sm *SaveManager) ClearSuspendedRound() error {
+	if err := os.Remove(sm.suspendedRoundPath()); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete suspended round: %w", err)
+	}
+
+	return nil
 }
 
 func (sm *SaveManager) Delete(slot int) error {
@@ -0,0 +1,61 @@
+package gogolf
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// SaveMigration upgrades a save from one version to the next. Saves are migrated as raw JSON,
+// before they are parsed into SaveData, so each step sees the file just as its version wrote it.
+// Each top-level field is left as written unless a step replaces it, so values a step does not
+// touch, such as the career's uint64 seeds, keep every digit.
+type SaveMigration func(save map[string]json.RawMessage) error
+
+// saveMigrations holds the step from each version to the one after it. Every change to the
+// save format bumps CurrentSaveVersion and adds the step from the version before.
+var saveMigrations = map[int]SaveMigration{
+	// version 2 marks saves that may carry a career, scoring record and suspended round; all of
+	// them are optional, so a version 1 save reads as it is
+	1: func(map[string]json.RawMessage) error { return nil },
+}
+
+// SaveVersionError is a save written by a newer version of the game than this one
+type SaveVersionError struct {
+	Version int
+}
+
+func (e *SaveVersionError) Error() string {
+	return fmt.Sprintf("save file is version %d but this game only reads up to version %d; update the game to load it", e.Version, CurrentSaveVersion)
+}
+
+// MigrateSave brings a save's JSON up to CurrentSaveVersion, applying each version's step in turn
+func MigrateSave(data []byte) ([]byte, error) {
+	var save map[string]json.RawMessage
+	if err := json.Unmarshal(data, &save); err != nil {
+		return nil, fmt.Errorf("failed to parse save file: %w", err)
+	}
+
+	var version int
+	if err := json.Unmarshal(save["version"], &version); err != nil || version < 1 {
+		return nil, fmt.Errorf("save file has no valid version: %s", save["version"])
+	}
+	switch {
+	case version > CurrentSaveVersion:
+		return nil, &SaveVersionError{Version: version}
+	case version == CurrentSaveVersion:
+		return data, nil
+	}
+
+	for ; version < CurrentSaveVersion; version++ {
+		step, ok := saveMigrations[version]
+		if !ok {
+			return nil, fmt.Errorf("no migration from save version %d", version)
+		}
+		if err := step(save); err != nil {
+			return nil, fmt.Errorf("failed to migrate save from version %d: %w", version, err)
+		}
+		save["version"] = json.RawMessage(strconv.Itoa(version + 1))
+	}
+	return json.Marshal(save)
+}
@@ -0,0 +1,169 @@
+package gogolf
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// loadFixture loads a save file from testdata/saves through slot 1 of a fresh save directory
+func loadFixture(t *testing.T, name string) (Golfer, error) {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", "saves", name))
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+	manager := NewSaveManager(t.TempDir())
+	if err := os.WriteFile(manager.slotPath(1), data, 0644); err != nil {
+		t.Fatalf("failed to write fixture: %v", err)
+	}
+	return manager.Load(1)
+}
+
+func TestLoadSaveFromEveryVersion(t *testing.T) {
+	for version := 1; version <= CurrentSaveVersion; version++ {
+		t.Run(fmt.Sprintf("v%d", version), func(t *testing.T) {
+			golfer, err := loadFixture(t, fmt.Sprintf("v%d.json", version))
+			if err != nil {
+				t.Fatalf("failed to load: %v", err)
+			}
+
+			if golfer.Name != "Fixture" || golfer.Money != 320 {
+				t.Errorf("expected Fixture with $320, got %s with $%d", golfer.Name, golfer.Money)
+			}
+			if driver := golfer.Skills["Driver"]; driver.Level != 3 || driver.Experience != 40 {
+				t.Errorf("expected Driver level 3 with 40 XP, got level %d with %d XP", driver.Level, driver.Experience)
+			}
+			if strength := golfer.Abilities["Strength"]; strength.Level != 2 || strength.Experience != 15 {
+				t.Errorf("expected Strength level 2 with 15 XP, got level %d with %d XP", strength.Level, strength.Experience)
+			}
+
+			ball := &Ball{Name: "Pro V1", DistanceBonus: 8, SpinControl: 0.9, Cost: 75}
+			glove := &Glove{Name: "Leather Pro", AccuracyBonus: 0.05, Cost: 45}
+			shoes := &Shoes{Name: "Tour Edition", LiePenaltyReduction: 3, Cost: 80}
+			if !reflect.DeepEqual(golfer.Ball, ball) {
+				t.Errorf("expected ball %+v, got %+v", ball, golfer.Ball)
+			}
+			if !reflect.DeepEqual(golfer.Glove, glove) {
+				t.Errorf("expected glove %+v, got %+v", glove, golfer.Glove)
+			}
+			if !reflect.DeepEqual(golfer.Shoes, shoes) {
+				t.Errorf("expected shoes %+v, got %+v", shoes, golfer.Shoes)
+			}
+		})
+	}
+}
+
+func TestEveryOlderVersionHasAMigration(t *testing.T) {
+	for version := 1; version < CurrentSaveVersion; version++ {
+		if saveMigrations[version] == nil {
+			t.Errorf("no migration from version %d", version)
+		}
+	}
+}
+
+func TestMigratedSaveIsCurrentVersion(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "saves", "v1.json"))
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	migrated, err := MigrateSave(data)
+	if err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+
+	manager := NewSaveManager(t.TempDir())
+	path := filepath.Join(t.TempDir(), "migrated.json")
+	if err := os.WriteFile(path, migrated, 0644); err != nil {
+		t.Fatalf("failed to write migrated save: %v", err)
+	}
+	saveData, err := manager.read(path)
+	if err != nil {
+		t.Fatalf("failed to read migrated save: %v", err)
+	}
+	if saveData.Version != CurrentSaveVersion {
+		t.Errorf("expected version %d, got %d", CurrentSaveVersion, saveData.Version)
+	}
+}
+
+func TestLoadNewerSaveReturnsVersionError(t *testing.T) {
+	manager := NewSaveManager(t.TempDir())
+	newer := fmt.Sprintf(`{"version": %d, "golfer_name": "Future"}`, CurrentSaveVersion+1)
+	if err := os.WriteFile(manager.slotPath(1), []byte(newer), 0644); err != nil {
+		t.Fatalf("failed to write save: %v", err)
+	}
+
+	_, err := manager.Load(1)
+
+	var versionErr *SaveVersionError
+	if !errors.As(err, &versionErr) {
+		t.Fatalf("expected a SaveVersionError, got %v", err)
+	}
+	if versionErr.Version != CurrentSaveVersion+1 {
+		t.Errorf("expected version %d in the error, got %d", CurrentSaveVersion+1, versionErr.Version)
+	}
+}
+
+func TestMigrateSaveRejectsMissingVersion(t *testing.T) {
+	for _, save := range []string{`{"golfer_name": "NoVersion"}`, `{"version": 0}`, `{"version": 1.5}`, `{"version": "1"}`} {
+		if _, err := MigrateSave([]byte(save)); err == nil {
+			t.Errorf("expected an error for %s", save)
+		}
+	}
+}
+
+func TestMigrateSaveLeavesCurrentVersionAlone(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "saves", fmt.Sprintf("v%d.json", CurrentSaveVersion)))
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	migrated, err := MigrateSave(data)
+	if err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	if string(migrated) != string(data) {
+		t.Error("expected a current save to be returned unchanged")
+	}
+}
+
+func TestMigrateSaveKeepsCareerSeeds(t *testing.T) {
+	golfer, err := loadFixture(t, "v1_career.json")
+	if err != nil {
+		t.Fatalf("failed to load: %v", err)
+	}
+
+	want, err := NewCareer("Fixture", 12345678901234567891)
+	if err != nil {
+		t.Fatalf("NewCareer returned error: %v", err)
+	}
+	if golfer.Career == nil {
+		t.Fatal("expected the career to load")
+	}
+	if golfer.Career.Seed != want.Seed {
+		t.Errorf("expected seed %d, got %d", want.Seed, golfer.Career.Seed)
+	}
+	for i, event := range golfer.Career.Calendar {
+		if event.CourseSeed != want.Calendar[i].CourseSeed {
+			t.Errorf("expected %s course seed %d, got %d", event.Name, want.Calendar[i].CourseSeed, event.CourseSeed)
+		}
+	}
+
+	migrated, err := MigrateSave([]byte(`{"version": 1, "career": {"seed": 18446744073709551615}}`))
+	if err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	var saveData SaveData
+	if err := json.Unmarshal(migrated, &saveData); err != nil {
+		t.Fatalf("failed to parse migrated save: %v", err)
+	}
+	if saveData.Career.Seed != math.MaxUint64 {
+		t.Errorf("expected seed %d, got %d", uint64(math.MaxUint64), saveData.Career.Seed)
+	}
+}
@@ -1,9 +1,12 @@
 package gogolf
 
 import (
+	"bytes"
 	"encoding/json"
 	"os"
 	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 )
@@ -138,6 +141,26 @@ func TestRestoreGolferFromSaveData(t *testing.T) {
 	}
 }
 
+func TestSavePreservesScoringRecord(t *testing.T) {
+	original := NewGolfer("TestPlayer")
+	original.ScoringRecord = []PostedScore{
+		{Course: "Meadowbrook", Holes: 3, Par: 12, Gross: 15, AdjustedGross: 14, Rating: 12.4, Slope: 121},
+	}
+
+	saveManager := NewSaveManager(t.TempDir())
+	if err := saveManager.Save(1, original); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+	loaded, err := saveManager.Load(1)
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(loaded.ScoringRecord, original.ScoringRecord) {
+		t.Errorf("ScoringRecord = %+v, want %+v", loaded.ScoringRecord, original.ScoringRecord)
+	}
+}
+
 func TestSaveAndLoadFile(t *testing.T) {
 	tempDir := t.TempDir()
 	manager := NewSaveManager(tempDir)
@@ -413,3 +436,89 @@ func TestSlotExistsCheck(t *testing.T) {
 		t.Error("slot 1 should exist after save")
 	}
 }
+
+func TestSuspendAndLoadRound(t *testing.T) {
+	manager := NewSaveManager(t.TempDir())
+	if manager.HasSuspendedRound() {
+		t.Error("there should be no suspended round initially")
+	}
+
+	golfer := NewGolfer("Suspender")
+	golfer.Money = 75
+	round := RoundData{
+		Course:           generateDefinition(t, 3, 11),
+		Scoring:          "Stableford",
+		CurrentHole:      1,
+		BallLocation:     Point{X: 40, Y: 120},
+		PreviousLocation: Point{X: 38, Y: 10},
+		ScoreCard: ScoreCardData{
+			Scores:    map[int]int{1: 5, 2: 1},
+			Penalties: map[int]int{1: 1},
+			PickedUp:  map[int]bool{1: true},
+			Shots:     map[int][]ShotRecord{2: {{Club: "Driver", StartLie: Tee, EndLie: Fairway, FromHole: 400, ToHole: 160}}},
+		},
+		Weather:  Weather{WindSpeed: 8, WindDirection: 90},
+		Random:   []byte{1, 2, 3},
+		LastShot: json.RawMessage(`{"ClubName":"Driver"}`),
+	}
+
+	if err := manager.SuspendRound(golfer, round); err != nil {
+		t.Fatalf("SuspendRound returned error: %v", err)
+	}
+	if !manager.HasSuspendedRound() {
+		t.Fatal("expected a suspended round after suspending")
+	}
+
+	loadedGolfer, loaded, err := manager.LoadSuspendedRound()
+	if err != nil {
+		t.Fatalf("LoadSuspendedRound returned error: %v", err)
+	}
+	if loadedGolfer.Name != "Suspender" || loadedGolfer.Money != 75 {
+		t.Errorf("expected the golfer as they were, got %s with %d", loadedGolfer.Name, loadedGolfer.Money)
+	}
+	var lastShot bytes.Buffer
+	if err := json.Compact(&lastShot, loaded.LastShot); err != nil || lastShot.String() != string(round.LastShot) {
+		t.Errorf("LastShot = %s, want %s", loaded.LastShot, round.LastShot)
+	}
+	loaded.LastShot, round.LastShot = nil, nil
+	if !reflect.DeepEqual(loaded, round) {
+		t.Error("the suspended round should load back as it was saved")
+	}
+
+	if err := manager.ClearSuspendedRound(); err != nil {
+		t.Fatalf("ClearSuspendedRound returned error: %v", err)
+	}
+	if manager.HasSuspendedRound() {
+		t.Error("the suspended round should be gone once cleared")
+	}
+	if err := manager.ClearSuspendedRound(); err != nil {
+		t.Errorf("clearing with nothing suspended should do nothing, got %v", err)
+	}
+}
+
+func TestSlotSavesHaveNoRound(t *testing.T) {
+	saveData := NewSaveData(NewGolfer("Test"))
+	jsonBytes, err := json.Marshal(saveData)
+	if err != nil {
+		t.Fatalf("failed to serialize: %v", err)
+	}
+	if strings.Contains(string(jsonBytes), `"round"`) {
+		t.Errorf("a save slot should not carry a round, got %s", jsonBytes)
+	}
+}
+
+func TestScoreCardDataRoundTrip(t *testing.T) {
+	course := Course{Holes: []Hole{{Number: 1, Par: 4}}}
+	card := NewScoreCard(course)
+	card.CourseHandicap = 3
+	playHole(&card, course.Holes[0], ShotRecord{Club: "Driver", StartLie: Tee, EndLie: Rough, Penalties: 1})
+
+	restored := NewScoreCardData(card).ToScoreCard(course)
+
+	if !reflect.DeepEqual(restored, card) {
+		t.Errorf("scorecard = %+v, want %+v", restored, card)
+	}
+	if empty := (ScoreCardData{}).ToScoreCard(course); empty.Scores == nil {
+		t.Error("a card with no scores should still be ready to record strokes")
+	}
+}
@@ -3,12 +3,119 @@ package gogolf
 type ScoreCard struct {
 	Course Course
 	Scores map[int]int
+	// Penalties counts the penalty strokes included in Scores for each hole
+	Penalties map[int]int
+	// PickedUp marks the holes abandoned without holing out; their Scores hold only the strokes taken
+	PickedUp map[int]bool
+	// CourseHandicap is the strokes the golfer receives over the course; negative for a plus handicap
+	CourseHandicap int
+	// Shots records every stroke played on each hole, in order; penalty strokes are charged to the shot that incurred them
+	Shots map[int][]ShotRecord
+}
+
+// ShotRecord is one stroke as it went on the card
+type ShotRecord struct {
+	Club     string  `json:"club"`
+	StartLie LieType `json:"start_lie"`
+	EndLie   LieType `json:"end_lie"`
+	// FromHole and ToHole are how far the ball lay from the hole before and after the shot
+	FromHole Yard              `json:"from_hole"`
+	ToHole   Yard              `json:"to_hole"`
+	Distance Yard              `json:"distance"`
+	Outcome  SkillCheckOutcome `json:"outcome"`
+	// Penalties counts the penalty strokes the shot cost, including relief taken afterwards
+	Penalties int  `json:"penalties,omitempty"`
+	Putt      bool `json:"putt,omitempty"`
+	HoledOut  bool `json:"holed_out,omitempty"`
+}
+
+func NewScoreCard(course Course) ScoreCard {
+	return ScoreCard{
+		Course: course,
+		Scores: map[int]int{},
+	}
 }
 
 func (sc *ScoreCard) RecordStroke(h Hole) {
 	sc.Scores[h.Number]++
 }
 
+// RecordPenalty adds a penalty stroke to the hole's score
+func (sc *ScoreCard) RecordPenalty(h Hole) {
+	if sc.Penalties == nil {
+		sc.Penalties = map[int]int{}
+	}
+	sc.Scores[h.Number]++
+	sc.Penalties[h.Number]++
+}
+
+// RecordPickUp marks the hole as abandoned without holing out
+func (sc *ScoreCard) RecordPickUp(h Hole) {
+	if sc.PickedUp == nil {
+		sc.PickedUp = map[int]bool{}
+	}
+	sc.PickedUp[h.Number] = true
+}
+
+// IsPickedUp reports whether the hole was abandoned without holing out
+func (sc ScoreCard) IsPickedUp(h Hole) bool {
+	return sc.PickedUp[h.Number]
+}
+
+// PickUpsThrough counts the holes up to holeNumber that were abandoned without holing out
+func (sc ScoreCard) PickUpsThrough(holeNumber int) (pickUps int) {
+	for k, v := range sc.PickedUp {
+		if v && k <= holeNumber {
+			pickUps++
+		}
+	}
+	return
+}
+
+// HasPickUps reports whether any hole was abandoned without holing out, leaving the card
+// without a true total
+func (sc ScoreCard) HasPickUps() bool {
+	for _, v := range sc.PickedUp {
+		if v {
+			return true
+		}
+	}
+	return false
+}
+
+// AddShot records the details of a stroke already counted on the hole
+func (sc *ScoreCard) AddShot(h Hole, shot ShotRecord) {
+	if sc.Shots == nil {
+		sc.Shots = map[int][]ShotRecord{}
+	}
+	sc.Shots[h.Number] = append(sc.Shots[h.Number], shot)
+}
+
+// ShotsThisHole returns the strokes recorded on the hole, in order
+func (sc ScoreCard) ShotsThisHole(h Hole) []ShotRecord {
+	return sc.Shots[h.Number]
+}
+
+// LastShot returns the last stroke recorded on the hole so it can be charged for relief, or nil if none has been
+func (sc ScoreCard) LastShot(h Hole) *ShotRecord {
+	shots := sc.Shots[h.Number]
+	if len(shots) == 0 {
+		return nil
+	}
+	return &shots[len(shots)-1]
+}
+
+func (sc ScoreCard) PenaltiesThisHole(h Hole) int {
+	return sc.Penalties[h.Number]
+}
+
+func (sc ScoreCard) TotalPenalties() (penalties int) {
+	for _, v := range sc.Penalties {
+		penalties += v
+	}
+	return
+}
+
 func (sc ScoreCard) TotalStrokesThrough(holeNumber int) (score int) {
 	for k, v := range sc.Scores {
 		if k <= holeNumber {
@@ -40,3 +147,47 @@ func (sc ScoreCard) Score() (score int) {
 func (sc ScoreCard) ScoreThrough(holeNumber int) (score int) {
 	return sc.TotalStrokesThrough(holeNumber) - sc.Course.ParUpToHole(holeNumber)
 }
+
+// StrokesReceived is how many of the course handicap's strokes fall on the hole. They are handed
+// out a round at a time, hardest hole first by stroke index; a plus handicap gives strokes back
+// on the easiest holes.
+func (sc ScoreCard) StrokesReceived(h Hole) int {
+	holes := len(sc.Course.Holes)
+	if holes == 0 {
+		return 0
+	}
+	index := sc.Course.StrokeIndex(h)
+	if sc.CourseHandicap < 0 {
+		given := -sc.CourseHandicap
+		strokes := -(given / holes)
+		if index > holes-given%holes {
+			strokes--
+		}
+		return strokes
+	}
+	strokes := sc.CourseHandicap / holes
+	if index <= sc.CourseHandicap%holes {
+		strokes++
+	}
+	return strokes
+}
+
+// NetStrokesThisHole is the hole's score less the strokes received on it
+func (sc ScoreCard) NetStrokesThisHole(h Hole) int {
+	return sc.TotalStrokesThisHole(h) - sc.StrokesReceived(h)
+}
+
+// NetTotalStrokes is the round's score less the strokes received on the holes played
+func (sc ScoreCard) NetTotalStrokes() (score int) {
+	for _, hole := range sc.Course.Holes {
+		if _, played := sc.Scores[hole.Number]; played {
+			score += sc.NetStrokesThisHole(hole)
+		}
+	}
+	return
+}
+
+// NetScore is the net score to par over the whole course
+func (sc ScoreCard) NetScore() int {
+	return sc.NetTotalStrokes() - sc.Course.Par()
+}
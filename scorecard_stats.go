@@ -0,0 +1,117 @@
+package gogolf
+
+// aroundTheGreen is how close to the hole a shot from off the green has to start to be a chance
+// to get up and down
+const aroundTheGreen = Yard(50)
+
+// HoleStats is what a hole's recorded shots say about how it was played
+type HoleStats struct {
+	Number  int
+	Par     int
+	Strokes int
+	Putts   int
+	// PickedUp is a hole abandoned without holing out, so Strokes is not a true score
+	PickedUp bool
+	// FairwayChance is a par 4 or 5, where the tee shot should finish on the fairway
+	FairwayChance bool
+	FairwayHit    bool
+	// GreenInRegulation is reaching the green in two strokes fewer than par
+	GreenInRegulation bool
+	// UpAndDownChance is a missed green with a shot from within aroundTheGreen, and UpAndDown
+	// holing out in two strokes from there; sand saves are up and downs from a bunker
+	UpAndDownChance bool
+	UpAndDown       bool
+	SandSaveChance  bool
+	SandSave        bool
+	// Scrambled is making par or better after missing the green in regulation
+	Scrambled bool
+}
+
+// HoleStats works out the hole's stats from the shots recorded on it
+func (sc ScoreCard) HoleStats(h Hole) HoleStats {
+	shots := sc.ShotsThisHole(h)
+	stats := HoleStats{Number: h.Number, Par: h.Par, Strokes: sc.TotalStrokesThisHole(h), PickedUp: sc.IsPickedUp(h)}
+	if len(shots) == 0 {
+		return stats
+	}
+	holedOut := shots[len(shots)-1].HoledOut
+
+	if h.Par >= 4 {
+		stats.FairwayChance = true
+		stats.FairwayHit = shots[0].EndLie == Fairway && shots[0].Penalties == 0 && !shots[0].HoledOut
+	}
+
+	strokes := 0
+	chance := -1
+	for i, shot := range shots {
+		if shot.Putt {
+			stats.Putts++
+		}
+		if chance < 0 && !shot.Putt && strokes >= h.Par-2 && shot.FromHole <= aroundTheGreen {
+			chance = i
+		}
+		strokes += 1 + shot.Penalties
+		if strokes <= h.Par-2 && (shot.EndLie == Green || shot.HoledOut) && shot.Penalties == 0 {
+			stats.GreenInRegulation = true
+		}
+	}
+
+	if !stats.GreenInRegulation && chance >= 0 {
+		stats.UpAndDownChance = true
+		stats.SandSaveChance = shots[chance].StartLie == Bunker
+		taken := 0
+		for _, shot := range shots[chance:] {
+			taken += 1 + shot.Penalties
+		}
+		stats.UpAndDown = holedOut && taken <= 2
+		stats.SandSave = stats.SandSaveChance && stats.UpAndDown
+	}
+	stats.Scrambled = !stats.GreenInRegulation && holedOut && stats.Strokes <= h.Par
+	return stats
+}
+
+// RoundStats totals the stats over every hole with shots recorded
+type RoundStats struct {
+	Holes              int
+	Putts              int
+	FairwaysHit        int
+	Fairways           int
+	GreensInRegulation int
+	UpAndDowns         int
+	UpAndDownChances   int
+	SandSaves          int
+	SandSaveChances    int
+	Scrambles          int
+	ScrambleChances    int
+}
+
+// Stats works out the round's stats from the shots recorded so far
+func (sc ScoreCard) Stats() RoundStats {
+	var stats RoundStats
+	for _, hole := range sc.Course.Holes {
+		if len(sc.ShotsThisHole(hole)) == 0 {
+			continue
+		}
+		hs := sc.HoleStats(hole)
+		stats.Holes++
+		stats.Putts += hs.Putts
+		stats.Fairways += tally(hs.FairwayChance)
+		stats.FairwaysHit += tally(hs.FairwayHit)
+		stats.GreensInRegulation += tally(hs.GreenInRegulation)
+		stats.UpAndDownChances += tally(hs.UpAndDownChance)
+		stats.UpAndDowns += tally(hs.UpAndDown)
+		stats.SandSaveChances += tally(hs.SandSaveChance)
+		stats.SandSaves += tally(hs.SandSave)
+		stats.ScrambleChances += tally(!hs.GreenInRegulation)
+		stats.Scrambles += tally(hs.Scrambled)
+	}
+	return stats
+}
+
+// tally counts a hole towards a total when b holds
+func tally(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
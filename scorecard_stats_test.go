@@ -0,0 +1,108 @@
+package gogolf
+
+import (
+	"testing"
+)
+
+// playHole records shots on the card as the game would
+func playHole(sc *ScoreCard, h Hole, shots ...ShotRecord) {
+	for _, shot := range shots {
+		for range shot.Penalties {
+			sc.RecordPenalty(h)
+		}
+		sc.RecordStroke(h)
+		sc.AddShot(h, shot)
+	}
+}
+
+func TestHoleStatsFairwayGreenAndPutts(t *testing.T) {
+	hole := Hole{Number: 1, Par: 4}
+	sc := NewScoreCard(Course{Holes: []Hole{hole}})
+	playHole(&sc, hole,
+		ShotRecord{Club: "Driver", StartLie: Tee, EndLie: Fairway, FromHole: 400, ToHole: 150},
+		ShotRecord{Club: "7 Iron", StartLie: Fairway, EndLie: Green, FromHole: 150, ToHole: 10},
+		ShotRecord{Club: "Putter", StartLie: Green, EndLie: Green, FromHole: 10, ToHole: 1, Putt: true},
+		ShotRecord{Club: "Putter", StartLie: Green, EndLie: Green, FromHole: 1, Putt: true, HoledOut: true},
+	)
+
+	stats := sc.HoleStats(hole)
+
+	if !stats.FairwayChance || !stats.FairwayHit || !stats.GreenInRegulation {
+		t.Errorf("expected fairway and green hit, got %+v", stats)
+	}
+	if stats.Putts != 2 || stats.Strokes != 4 {
+		t.Errorf("expected 4 strokes with 2 putts, got %+v", stats)
+	}
+	if stats.UpAndDownChance || stats.Scrambled {
+		t.Errorf("a green in regulation is no chance to scramble, got %+v", stats)
+	}
+}
+
+func TestHoleStatsSandSave(t *testing.T) {
+	hole := Hole{Number: 1, Par: 4}
+	sc := NewScoreCard(Course{Holes: []Hole{hole}})
+	playHole(&sc, hole,
+		ShotRecord{StartLie: Tee, EndLie: Rough, FromHole: 400, ToHole: 160},
+		ShotRecord{StartLie: Rough, EndLie: Bunker, FromHole: 160, ToHole: 15},
+		ShotRecord{StartLie: Bunker, EndLie: Green, FromHole: 15, ToHole: 2},
+		ShotRecord{StartLie: Green, EndLie: Green, FromHole: 2, Putt: true, HoledOut: true},
+	)
+
+	stats := sc.HoleStats(hole)
+
+	if stats.FairwayHit || stats.GreenInRegulation {
+		t.Errorf("expected fairway and green missed, got %+v", stats)
+	}
+	if !stats.SandSaveChance || !stats.SandSave || !stats.UpAndDown || !stats.Scrambled {
+		t.Errorf("up and down from the bunker for par is a sand save, got %+v", stats)
+	}
+}
+
+func TestHoleStatsPenaltyMissesTheFairway(t *testing.T) {
+	hole := Hole{Number: 1, Par: 5}
+	sc := NewScoreCard(Course{Holes: []Hole{hole}})
+	playHole(&sc, hole,
+		ShotRecord{StartLie: Tee, EndLie: Fairway, FromHole: 500, ToHole: 500, Penalties: 1},
+		ShotRecord{StartLie: Tee, EndLie: Fairway, FromHole: 500, ToHole: 250},
+		ShotRecord{StartLie: Fairway, EndLie: FirstCut, FromHole: 250, ToHole: 30},
+		ShotRecord{StartLie: FirstCut, EndLie: Green, FromHole: 30, ToHole: 8},
+		ShotRecord{StartLie: Green, EndLie: Green, FromHole: 8, ToHole: 1, Putt: true},
+		ShotRecord{StartLie: Green, EndLie: Green, FromHole: 1, Putt: true, HoledOut: true},
+	)
+
+	stats := sc.HoleStats(hole)
+
+	if stats.FairwayHit {
+		t.Error("a tee shot out of bounds should not count as a fairway hit")
+	}
+	if !stats.UpAndDownChance || stats.UpAndDown || stats.Scrambled {
+		t.Errorf("three from the first cut is a missed up and down, got %+v", stats)
+	}
+}
+
+func TestRoundStatsTotalsHoles(t *testing.T) {
+	par3 := Hole{Number: 1, Par: 3}
+	par4 := Hole{Number: 2, Par: 4}
+	unplayed := Hole{Number: 3, Par: 5}
+	sc := NewScoreCard(Course{Holes: []Hole{par3, par4, unplayed}})
+	playHole(&sc, par3,
+		ShotRecord{StartLie: Tee, EndLie: Green, FromHole: 160, ToHole: 5},
+		ShotRecord{StartLie: Green, EndLie: Green, FromHole: 5, Putt: true, HoledOut: true},
+	)
+	playHole(&sc, par4,
+		ShotRecord{StartLie: Tee, EndLie: Rough, FromHole: 380, ToHole: 140},
+		ShotRecord{StartLie: Rough, EndLie: FirstCut, FromHole: 140, ToHole: 20},
+		ShotRecord{StartLie: FirstCut, EndLie: Green, FromHole: 20, ToHole: 1},
+		ShotRecord{StartLie: Green, EndLie: Green, FromHole: 1, Putt: true, HoledOut: true},
+	)
+
+	stats := sc.Stats()
+
+	want := RoundStats{
+		Holes: 2, Putts: 2, FairwaysHit: 0, Fairways: 1, GreensInRegulation: 1,
+		UpAndDowns: 1, UpAndDownChances: 1, Scrambles: 1, ScrambleChances: 1,
+	}
+	if stats != want {
+		t.Errorf("Stats = %+v, want %+v", stats, want)
+	}
+}
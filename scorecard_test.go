@@ -10,7 +10,7 @@ func TestRecordScore(t *testing.T) {
 		Par:    3,
 	}
 	sc := ScoreCard{
-		Course: Course{[]Hole{hole}},
+		Course: Course{Holes: []Hole{hole}},
 		Scores: map[int]int{},
 	}
 
@@ -33,7 +33,7 @@ func TestScore(t *testing.T) {
 		Par:    4,
 	}
 	sc := ScoreCard{
-		Course: Course{[]Hole{hole, hole2}},
+		Course: Course{Holes: []Hole{hole, hole2}},
 		Scores: map[int]int{},
 	}
 
@@ -61,7 +61,7 @@ func TestTotalStrokes(t *testing.T) {
 		Par:    4,
 	}
 	sc := ScoreCard{
-		Course: Course{[]Hole{hole}},
+		Course: Course{Holes: []Hole{hole}},
 		Scores: map[int]int{},
 	}
 
@@ -78,3 +78,86 @@ func TestTotalStrokes(t *testing.T) {
 		t.Error("Expected strokes to be 6, but got", strokes)
 	}
 }
+
+func TestRecordPickUp(t *testing.T) {
+	hole := Hole{Number: 1, Par: 4}
+	hole2 := Hole{Number: 2, Par: 3}
+	sc := NewScoreCard(Course{Holes: []Hole{hole, hole2}})
+
+	sc.RecordStroke(hole)
+	sc.RecordStroke(hole)
+	if sc.HasPickUps() {
+		t.Error("Expected a card with no pick ups")
+	}
+
+	sc.RecordPickUp(hole2)
+
+	if !sc.IsPickedUp(hole2) || sc.IsPickedUp(hole) {
+		t.Errorf("Expected only hole 2 to be picked up, got %v", sc.PickedUp)
+	}
+	if !sc.HasPickUps() {
+		t.Error("Expected the card to show a pick up")
+	}
+	if got := sc.PickUpsThrough(1); got != 0 {
+		t.Errorf("Expected no pick ups through hole 1, got %d", got)
+	}
+	if got := sc.PickUpsThrough(2); got != 1 {
+		t.Errorf("Expected 1 pick up through hole 2, got %d", got)
+	}
+	if strokes := sc.TotalStrokes(); strokes != 2 {
+		t.Error("Expected a pick up to leave only the strokes taken, but got", strokes)
+	}
+}
+
+func TestStrokesReceivedByStrokeIndex(t *testing.T) {
+	holes := []Hole{{Number: 1, Par: 4, StrokeIndex: 3}, {Number: 2, Par: 3, StrokeIndex: 1}, {Number: 3, Par: 5, StrokeIndex: 2}}
+	tests := []struct {
+		handicap int
+		want     []int
+	}{
+		{0, []int{0, 0, 0}},
+		{2, []int{0, 1, 1}},
+		{4, []int{1, 2, 1}},
+		{-1, []int{-1, 0, 0}},
+	}
+
+	for _, tt := range tests {
+		sc := ScoreCard{Course: Course{Holes: holes}, Scores: map[int]int{}, CourseHandicap: tt.handicap}
+		for i, hole := range holes {
+			if got := sc.StrokesReceived(hole); got != tt.want[i] {
+				t.Errorf("handicap %d: hole %d receives %d, want %d", tt.handicap, hole.Number, got, tt.want[i])
+			}
+		}
+	}
+}
+
+func TestNetScore(t *testing.T) {
+	holes := []Hole{{Number: 1, Par: 4, StrokeIndex: 2}, {Number: 2, Par: 4, StrokeIndex: 1}}
+	sc := ScoreCard{Course: Course{Holes: holes}, Scores: map[int]int{1: 5, 2: 6}, CourseHandicap: 3}
+
+	if got := sc.NetStrokesThisHole(holes[1]); got != 4 {
+		t.Errorf("NetStrokesThisHole = %d, want 4", got)
+	}
+	if got := sc.NetTotalStrokes(); got != 8 {
+		t.Errorf("NetTotalStrokes = %d, want 8", got)
+	}
+	if got := sc.NetScore(); got != 0 {
+		t.Errorf("NetScore = %d, want 0", got)
+	}
+}
+
+func TestLastShotCanBeCharged(t *testing.T) {
+	hole := Hole{Number: 1, Par: 4}
+	sc := NewScoreCard(Course{Holes: []Hole{hole}})
+	if sc.LastShot(hole) != nil {
+		t.Fatal("expected no last shot before any are recorded")
+	}
+
+	sc.RecordStroke(hole)
+	sc.AddShot(hole, ShotRecord{Club: "Driver", StartLie: Tee, EndLie: PenaltyArea})
+	sc.LastShot(hole).Penalties++
+
+	if shots := sc.ShotsThisHole(hole); len(shots) != 1 || shots[0].Penalties != 1 {
+		t.Errorf("expected the relief to be charged to the drive, got %+v", shots)
+	}
+}
@@ -0,0 +1,324 @@
+package gogolf
+
+import (
+	"fmt"
+	"math"
+)
+
+// ScoringFormat turns the strokes on golfers' scorecards into a result, such as total strokes
+// or Stableford points, and decides what each golfer earns for a hole
+type ScoringFormat interface {
+	Name() string
+	// Score is each card's result through the given hole number, in the same order as cards
+	Score(cards []ScoreCard, through int) []int
+	// HoleRewards is the money each card earns for hole h
+	HoleRewards(cards []ScoreCard, h Hole) []int
+	// LowerIsBetter reports whether the lowest score leads, as with strokes
+	LowerIsBetter() bool
+	// FormatScore describes a result, e.g. "36 pts"
+	FormatScore(score int) string
+}
+
+// ScoringFormats lists every format, stroke play first
+func ScoringFormats() []ScoringFormat {
+	return []ScoringFormat{StrokePlay{}, Stableford{}, Stableford{Modified: true}, Skins{}, ParBogey{}}
+}
+
+// holeInOneReward is paid for an ace whatever the format
+const holeInOneReward = 100
+
+// noScore stands in for the strokes on a hole that was picked up: a golfer who did not hole
+// out does worse than any golfer who did
+const noScore = math.MaxInt32
+
+// holeResult is the card's strokes on h as the formats compare them, or noScore if it was picked up
+func holeResult(card ScoreCard, h Hole) int {
+	if card.IsPickedUp(h) {
+		return noScore
+	}
+	return card.TotalStrokesThisHole(h)
+}
+
+// playedHoles returns the holes up to and including through that every card has a score for
+func playedHoles(cards []ScoreCard, through int) []Hole {
+	if len(cards) == 0 {
+		return nil
+	}
+	var holes []Hole
+	for _, h := range cards[0].Course.Holes {
+		if h.Number > through {
+			break
+		}
+		for _, card := range cards {
+			if card.Scores[h.Number] == 0 {
+				return holes
+			}
+		}
+		holes = append(holes, h)
+	}
+	return holes
+}
+
+// scoreEachCard adds up points for every hole each card has played through the given hole
+func scoreEachCard(cards []ScoreCard, through int, points func(par, strokes int) int) []int {
+	scores := make([]int, len(cards))
+	for i, card := range cards {
+		for _, h := range card.Course.Holes {
+			if h.Number > through {
+				break
+			}
+			if card.Scores[h.Number] > 0 {
+				scores[i] += points(h.Par, holeResult(card, h))
+			}
+		}
+	}
+	return scores
+}
+
+// rewardEachCard pays every card for hole h by its strokes alone
+func rewardEachCard(cards []ScoreCard, h Hole, reward func(par, strokes int) int) []int {
+	rewards := make([]int, len(cards))
+	for i, card := range cards {
+		strokes := holeResult(card, h)
+		rewards[i] = reward(h.Par, strokes)
+		if strokes == 1 {
+			rewards[i] = max(rewards[i], holeInOneReward)
+		}
+	}
+	return rewards
+}
+
+// StrokePlay counts every stroke; the lowest total wins. A card with a picked-up hole has
+// no true total, so its score is only the strokes taken.
+type StrokePlay struct {
+	// ForPurse plays for a tournament purse paid out at the end of the event, so holes earn nothing
+	ForPurse bool
+}
+
+func (StrokePlay) Name() string {
+	return "Stroke Play"
+}
+
+func (StrokePlay) Score(cards []ScoreCard, through int) []int {
+	scores := make([]int, len(cards))
+	for i, card := range cards {
+		scores[i] = card.TotalStrokesThrough(through)
+	}
+	return scores
+}
+
+func (s StrokePlay) HoleRewards(cards []ScoreCard, h Hole) []int {
+	if s.ForPurse {
+		return make([]int, len(cards))
+	}
+	return rewardEachCard(cards, h, CalculateHoleReward)
+}
+
+func (StrokePlay) LowerIsBetter() bool {
+	return true
+}
+
+func (StrokePlay) FormatScore(score int) string {
+	return fmt.Sprintf("%d", score)
+}
+
+// Stableford awards points on each hole by the score against par. The standard table gives
+// 2 for a par and one more for each stroke under; the modified table, used by the pros,
+// rewards birdies and eagles more heavily and takes points away for bogeys.
+type Stableford struct {
+	Modified bool
+}
+
+// stablefordReward is what each point is worth
+const stablefordReward = 5
+
+// StablefordPoints is the standard Stableford score for a hole:
+// 0 for double bogey or worse, 1 bogey, 2 par, 3 birdie, 4 eagle, 5 albatross
+func StablefordPoints(par, strokes int) int {
+	return max(2-(strokes-par), 0)
+}
+
+// ModifiedStablefordPoints is the modified Stableford score for a hole:
+// -3 for double bogey or worse, -1 bogey, 0 par, 2 birdie, 5 eagle, 8 albatross
+func ModifiedStablefordPoints(par, strokes int) int {
+	switch scoreToPar := strokes - par; {
+	case scoreToPar >= 2:
+		return -3
+	case scoreToPar == 1:
+		return -1
+	case scoreToPar == 0:
+		return 0
+	case scoreToPar == -1:
+		return 2
+	case scoreToPar == -2:
+		return 5
+	default:
+		return 8
+	}
+}
+
+func (s Stableford) points(par, strokes int) int {
+	if s.Modified {
+		return ModifiedStablefordPoints(par, strokes)
+	}
+	return StablefordPoints(par, strokes)
+}
+
+func (s Stableford) Name() string {
+	if s.Modified {
+		return "Modified Stableford"
+	}
+	return "Stableford"
+}
+
+func (s Stableford) Score(cards []ScoreCard, through int) []int {
+	return scoreEachCard(cards, through, s.points)
+}
+
+// HoleRewards pays for every point. Modified points are shifted so a double bogey still
+// earns the minimum and a par earns the same as in stroke play.
+func (s Stableford) HoleRewards(cards []ScoreCard, h Hole) []int {
+	return rewardEachCard(cards, h, func(par, strokes int) int {
+		points := s.points(par, strokes)
+		if s.Modified {
+			points += 2
+		}
+		return max(points*stablefordReward, 1)
+	})
+}
+
+func (Stableford) LowerIsBetter() bool {
+	return false
+}
+
+func (Stableford) FormatScore(score int) string {
+	return fmt.Sprintf("%d pts", score)
+}
+
+// Skins puts a skin up on every hole for the golfer with the outright lowest score.
+// When the best score is tied the skin carries over to the next hole, as it does when
+// everyone picked up.
+type Skins struct{}
+
+// skinReward is what each skin won is worth
+const skinReward = 25
+
+// skinWinner returns the card with the outright lowest score on h, or -1 when it is tied
+func skinWinner(cards []ScoreCard, h Hole) int {
+	winner, best, tied := -1, 0, false
+	for i, card := range cards {
+		strokes := holeResult(card, h)
+		switch {
+		case winner < 0 || strokes < best:
+			winner, best, tied = i, strokes, false
+		case strokes == best:
+			tied = true
+		}
+	}
+	if tied {
+		return -1
+	}
+	return winner
+}
+
+func (Skins) Name() string {
+	return "Skins"
+}
+
+// Score counts the skins each card has won. Skins still carrying over are not counted.
+func (Skins) Score(cards []ScoreCard, through int) []int {
+	won := make([]int, len(cards))
+	carry := 0
+	for _, h := range playedHoles(cards, through) {
+		carry++
+		if winner := skinWinner(cards, h); winner >= 0 {
+			won[winner] += carry
+			carry = 0
+		}
+	}
+	return won
+}
+
+// HoleRewards pays the winner of the hole for every skin they collect, carryovers included
+func (s Skins) HoleRewards(cards []ScoreCard, h Hole) []int {
+	before := s.Score(cards, h.Number-1)
+	after := s.Score(cards, h.Number)
+	rewards := make([]int, len(cards))
+	for i, card := range cards {
+		rewards[i] = max((after[i]-before[i])*skinReward, 1)
+		if holeResult(card, h) == 1 {
+			rewards[i] = max(rewards[i], holeInOneReward)
+		}
+	}
+	return rewards
+}
+
+func (Skins) LowerIsBetter() bool {
+	return false
+}
+
+func (Skins) FormatScore(score int) string {
+	if score == 1 {
+		return "1 skin"
+	}
+	return fmt.Sprintf("%d skins", score)
+}
+
+// ParBogey is a match against the course: beating par wins the hole, a par halves it and
+// anything worse loses it. The result is how many holes up or down the golfer finishes.
+type ParBogey struct{}
+
+// Rewards for winning, halving and losing a hole against the course
+const (
+	parBogeyWinReward  = 20
+	parBogeyHalfReward = 10
+	parBogeyLossReward = 1
+)
+
+func parBogeyResult(par, strokes int) int {
+	switch {
+	case strokes < par:
+		return 1
+	case strokes == par:
+		return 0
+	default:
+		return -1
+	}
+}
+
+func (ParBogey) Name() string {
+	return "Par/Bogey"
+}
+
+func (ParBogey) Score(cards []ScoreCard, through int) []int {
+	return scoreEachCard(cards, through, parBogeyResult)
+}
+
+func (ParBogey) HoleRewards(cards []ScoreCard, h Hole) []int {
+	return rewardEachCard(cards, h, func(par, strokes int) int {
+		switch parBogeyResult(par, strokes) {
+		case 1:
+			return parBogeyWinReward
+		case 0:
+			return parBogeyHalfReward
+		default:
+			return parBogeyLossReward
+		}
+	})
+}
+
+func (ParBogey) LowerIsBetter() bool {
+	return false
+}
+
+// FormatScore describes holes against the course, e.g. "2 UP", "1 DOWN" or "All square"
+func (ParBogey) FormatScore(score int) string {
+	switch {
+	case score > 0:
+		return fmt.Sprintf("%d UP", score)
+	case score < 0:
+		return fmt.Sprintf("%d DOWN", -score)
+	default:
+		return "All square"
+	}
+}
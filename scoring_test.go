@@ -0,0 +1,173 @@
+package gogolf
+
+import "testing"
+
+var scoringCourse = Course{Holes: []Hole{{Number: 1, Par: 4}, {Number: 2, Par: 3}, {Number: 3, Par: 5}}}
+
+// cardWith returns a scorecard on scoringCourse with the given strokes for holes 1, 2, 3...
+func cardWith(strokes ...int) ScoreCard {
+	card := NewScoreCard(scoringCourse)
+	for i, s := range strokes {
+		card.Scores[i+1] = s
+	}
+	return card
+}
+
+func TestStablefordPoints(t *testing.T) {
+	tests := []struct {
+		strokes  int
+		standard int
+		modified int
+	}{
+		{7, 0, -3},
+		{6, 0, -3},
+		{5, 1, -1},
+		{4, 2, 0},
+		{3, 3, 2},
+		{2, 4, 5},
+		{1, 5, 8},
+	}
+
+	for _, tt := range tests {
+		if got := StablefordPoints(4, tt.strokes); got != tt.standard {
+			t.Errorf("StablefordPoints(4, %d) = %d, want %d", tt.strokes, got, tt.standard)
+		}
+		if got := ModifiedStablefordPoints(4, tt.strokes); got != tt.modified {
+			t.Errorf("ModifiedStablefordPoints(4, %d) = %d, want %d", tt.strokes, got, tt.modified)
+		}
+	}
+}
+
+func TestScoringFormat_Score(t *testing.T) {
+	// birdie, par, double bogey
+	card := cardWith(3, 3, 7)
+
+	tests := []struct {
+		format   ScoringFormat
+		expected int
+		display  string
+	}{
+		{StrokePlay{}, 13, "13"},
+		{Stableford{}, 5, "5 pts"},
+		{Stableford{Modified: true}, -1, "-1 pts"},
+		{ParBogey{}, 0, "All square"},
+	}
+
+	for _, tt := range tests {
+		got := tt.format.Score([]ScoreCard{card}, 3)[0]
+		if got != tt.expected {
+			t.Errorf("%s score = %d, want %d", tt.format.Name(), got, tt.expected)
+		}
+		if display := tt.format.FormatScore(got); display != tt.display {
+			t.Errorf("%s FormatScore(%d) = %q, want %q", tt.format.Name(), got, display, tt.display)
+		}
+	}
+
+	if got := (Stableford{}).Score([]ScoreCard{card}, 2)[0]; got != 5 {
+		t.Errorf("Stableford through 2 = %d, want 5", got)
+	}
+}
+
+func TestSkins_CarryOver(t *testing.T) {
+	alice := cardWith(4, 3, 4)
+	bob := cardWith(4, 3, 5)
+	cara := cardWith(5, 3, 6)
+	cards := []ScoreCard{alice, bob, cara}
+
+	if got := (Skins{}).Score(cards, 2); got[0]+got[1]+got[2] != 0 {
+		t.Errorf("tied holes should carry the skins over, got %v", got)
+	}
+
+	got := Skins{}.Score(cards, 3)
+	if got[0] != 3 || got[1] != 0 || got[2] != 0 {
+		t.Errorf("Skins.Score = %v, want Alice to collect all 3 skins", got)
+	}
+
+	rewards := Skins{}.HoleRewards(cards, scoringCourse.Holes[2])
+	if rewards[0] != 3*skinReward || rewards[1] != 1 {
+		t.Errorf("Skins.HoleRewards = %v, want %d for the carried skins", rewards, 3*skinReward)
+	}
+	if got := (Skins{}).FormatScore(1); got != "1 skin" {
+		t.Errorf("FormatScore(1) = %q", got)
+	}
+}
+
+func TestParBogey(t *testing.T) {
+	card := cardWith(3, 3, 6)
+
+	if got := (ParBogey{}).Score([]ScoreCard{card}, 3)[0]; got != 0 {
+		t.Errorf("win, half, loss should be all square, got %d", got)
+	}
+	if got := (ParBogey{}).FormatScore(2); got != "2 UP" {
+		t.Errorf("FormatScore(2) = %q", got)
+	}
+	if got := (ParBogey{}).FormatScore(-1); got != "1 DOWN" {
+		t.Errorf("FormatScore(-1) = %q", got)
+	}
+
+	rewards := ParBogey{}.HoleRewards([]ScoreCard{card}, scoringCourse.Holes[0])
+	if rewards[0] != parBogeyWinReward {
+		t.Errorf("winning the hole should pay %d, got %d", parBogeyWinReward, rewards[0])
+	}
+}
+
+func TestHoleRewards_ByFormat(t *testing.T) {
+	par := []ScoreCard{cardWith(4)}
+	hole := scoringCourse.Holes[0]
+
+	if got := (StrokePlay{}).HoleRewards(par, hole)[0]; got != CalculateHoleReward(4, 4) {
+		t.Errorf("stroke play should pay CalculateHoleReward, got %d", got)
+	}
+	if got := (Stableford{}).HoleRewards(par, hole)[0]; got != 2*stablefordReward {
+		t.Errorf("a Stableford par should pay 2 points, got %d", got)
+	}
+	if got := (Stableford{Modified: true}).HoleRewards(par, hole)[0]; got != 2*stablefordReward {
+		t.Errorf("a modified Stableford par should pay the same as standard, got %d", got)
+	}
+	ace := []ScoreCard{cardWith(4, 1)}
+	for _, format := range ScoringFormats() {
+		if got := format.HoleRewards(ace, scoringCourse.Holes[1])[0]; got < holeInOneReward {
+			t.Errorf("%s pays %d for an ace, want at least %d", format.Name(), got, holeInOneReward)
+		}
+	}
+}
+
+func TestScoringFormat_PickedUpHoleScoresNothing(t *testing.T) {
+	// picked up on the par 3 second after a single stroke
+	card := cardWith(4, 1, 5)
+	card.RecordPickUp(scoringCourse.Holes[1])
+
+	tests := []struct {
+		format   ScoringFormat
+		expected int
+	}{
+		{StrokePlay{}, 10},
+		{Stableford{}, 4},
+		{Stableford{Modified: true}, -3},
+		{ParBogey{}, -1},
+	}
+
+	for _, tt := range tests {
+		if got := tt.format.Score([]ScoreCard{card}, 3)[0]; got != tt.expected {
+			t.Errorf("%s score with a pick up = %d, want %d", tt.format.Name(), got, tt.expected)
+		}
+		if got := tt.format.HoleRewards([]ScoreCard{card}, scoringCourse.Holes[1])[0]; got >= holeInOneReward {
+			t.Errorf("%s pays %d for a picked up hole as though it were an ace", tt.format.Name(), got)
+		}
+	}
+}
+
+func TestSkins_PickUpCannotWinASkin(t *testing.T) {
+	alice := cardWith(4, 1)
+	alice.RecordPickUp(scoringCourse.Holes[1])
+	bob := cardWith(4, 4)
+
+	if got := (Skins{}).Score([]ScoreCard{alice, bob}, 2); got[0] != 0 || got[1] != 2 {
+		t.Errorf("Skins.Score = %v, want Bob to collect both skins over a golfer who picked up", got)
+	}
+
+	bob.RecordPickUp(scoringCourse.Holes[1])
+	if got := (Skins{}).Score([]ScoreCard{alice, bob}, 2); got[0]+got[1] != 0 {
+		t.Errorf("a hole everyone picked up should carry the skin over, got %v", got)
+	}
+}
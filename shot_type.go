@@ -0,0 +1,136 @@
+package gogolf
+
+import "math"
+
+// ShotType is the technique used for a swing: a full swing or one of the short-game shots
+type ShotType int
+
+const (
+	FullSwing ShotType = iota
+	Chip
+	Pitch
+	Flop
+	Punch
+	Splash
+)
+
+// shotTypeSpec describes how a shot type changes a club's flight
+type shotTypeSpec struct {
+	name string
+	// carry is the share of the distance flown in the air; zero keeps the club's own
+	carry float64
+	// distance is the share of the club's full distance the shot travels at full power
+	distance float64
+	// loft is added to the club's loft, opening the face for height or delofting to keep the ball down
+	loft float32
+	// difficulty is added to the target number
+	difficulty int
+	// spread scales how far off line a shot finishes
+	spread float64
+	// mishit scales the distance lost on a poor strike
+	mishit float64
+	// clubs lists the clubs the shot can be played with
+	clubs []string
+}
+
+var shotTypeSpecs = [...]shotTypeSpec{
+	FullSwing: {name: "Full Swing", distance: 1, spread: 1, mishit: 1},
+	Chip: {name: "Chip", carry: 0.3, distance: 0.3, loft: -4, difficulty: 1, spread: 0.5, mishit: 0.6,
+		clubs: []string{"7 Iron", "8 Iron", "9 Iron", "PW", "GW", "SW", "LW"}},
+	Pitch: {name: "Pitch", carry: 0.75, distance: 0.6, difficulty: 0, spread: 0.8, mishit: 0.9,
+		clubs: []string{"PW", "GW", "SW", "LW"}},
+	Flop: {name: "Flop", carry: 0.92, distance: 0.4, loft: 14, difficulty: -3, spread: 1.4, mishit: 1.5,
+		clubs: []string{"SW", "LW"}},
+	Punch: {name: "Punch", carry: 0.45, distance: 0.6, loft: -12, difficulty: -1, spread: 0.8, mishit: 0.8,
+		clubs: []string{"4 Iron", "5 Iron", "6 Iron", "7 Iron", "8 Iron", "9 Iron"}},
+	Splash: {name: "Bunker Splash", carry: 0.85, distance: 0.35, loft: 6, difficulty: 3, spread: 1.2, mishit: 1.2,
+		clubs: []string{"GW", "SW", "LW"}},
+}
+
+// minimumLoft keeps a delofted club from launching below the ground
+const minimumLoft = 2
+
+func (t ShotType) String() string {
+	return shotTypeSpecs[t].name
+}
+
+// ShotTypes lists every shot type, full swing first
+func ShotTypes() []ShotType {
+	return []ShotType{FullSwing, Chip, Pitch, Flop, Punch, Splash}
+}
+
+// AvailableShotTypes lists the shot types that can be played with club from lie, full swing first
+func AvailableShotTypes(club Club, lie LieType) []ShotType {
+	var types []ShotType
+	for _, t := range ShotTypes() {
+		if t.Allows(club, lie) {
+			types = append(types, t)
+		}
+	}
+	return types
+}
+
+// Allows reports whether the shot can be played with club from lie.
+// A full swing is always allowed; the bunker splash can only be played from sand.
+func (t ShotType) Allows(club Club, lie LieType) bool {
+	if t == FullSwing {
+		return true
+	}
+	if t == Splash && lie != Bunker {
+		return false
+	}
+	for _, name := range shotTypeSpecs[t].clubs {
+		if name == club.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// Apply returns club as it plays for this shot: shorter for the short-game shots,
+// with the face opened or delofted
+func (t ShotType) Apply(club Club) Club {
+	spec := shotTypeSpecs[t]
+	club.Distance *= Yard(spec.distance)
+	club.Loft = float32(math.Max(float64(club.Loft+spec.loft), minimumLoft))
+	return club
+}
+
+// CarryFraction is the share of the shot's distance covered in the air; a full swing uses the club's own
+func (t ShotType) CarryFraction(club Club) float64 {
+	if spec := shotTypeSpecs[t]; spec.carry > 0 && club.Name != "Putter" {
+		return spec.carry
+	}
+	return club.CarryFraction()
+}
+
+// DifficultyModifier is added to the target number for playing this shot from lie.
+// A flop is easier from a fluffy lie, and the splash is only easy because it is played from sand.
+func (t ShotType) DifficultyModifier(lie LieType) int {
+	modifier := shotTypeSpecs[t].difficulty
+	if t == Flop && (lie == Rough || lie == DeepRough) {
+		modifier++
+	}
+	return modifier
+}
+
+// UnderCoverModifier is added to the target number when the ball lies beneath branches.
+// Only a punch, played with a short, low swing, escapes without the branches getting in the way.
+func (t ShotType) UnderCoverModifier() int {
+	if t == Punch {
+		return 0
+	}
+	return -3
+}
+
+// CalculateRotationWithType scales CalculateRotation by how forgiving the shot type is of line
+func CalculateRotationWithType(club Club, result SkillCheckResult, shotType ShotType, random RandomSource) float64 {
+	return CalculateRotation(club, result, random) * shotTypeSpecs[shotType].spread
+}
+
+// CalculatePowerWithType scales the distance CalculatePower gains or loses by how the shot type
+// punishes a mishit: a chip barely suffers a thin strike, a flop can be skulled or left in the grass
+func CalculatePowerWithType(club Club, initialPower float64, result SkillCheckResult, shotType ShotType) float64 {
+	power := CalculatePower(club, initialPower, result)
+	return math.Max(initialPower+(power-initialPower)*shotTypeSpecs[shotType].mishit, 0.1*initialPower)
+}
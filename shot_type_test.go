@@ -0,0 +1,151 @@
+package gogolf
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+func clubNamed(name string) Club {
+	for _, club := range DefaultClubs() {
+		if club.Name == name {
+			return club
+		}
+	}
+	return Club{}
+}
+
+func TestShotType_Allows(t *testing.T) {
+	tests := []struct {
+		shotType ShotType
+		club     string
+		lie      LieType
+		expected bool
+	}{
+		{FullSwing, "Driver", Tee, true},
+		{FullSwing, "Putter", Green, true},
+		{Chip, "8 Iron", FirstCut, true},
+		{Chip, "Driver", FirstCut, false},
+		{Pitch, "GW", Fairway, true},
+		{Pitch, "7 Iron", Fairway, false},
+		{Flop, "LW", Rough, true},
+		{Flop, "PW", Rough, false},
+		{Punch, "5 Iron", DeepRough, true},
+		{Punch, "LW", DeepRough, false},
+		{Splash, "SW", Bunker, true},
+		{Splash, "SW", Fairway, false},
+		{Splash, "7 Iron", Bunker, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.shotType.Allows(clubNamed(tt.club), tt.lie); got != tt.expected {
+			t.Errorf("%s.Allows(%s, %s) = %v, want %v", tt.shotType, tt.club, tt.lie, got, tt.expected)
+		}
+	}
+}
+
+func TestAvailableShotTypes(t *testing.T) {
+	if got := AvailableShotTypes(clubNamed("Driver"), Fairway); len(got) != 1 || got[0] != FullSwing {
+		t.Errorf("driver should only offer a full swing, got %v", got)
+	}
+
+	got := AvailableShotTypes(clubNamed("SW"), Bunker)
+	expected := []ShotType{FullSwing, Chip, Pitch, Flop, Splash}
+	if len(got) != len(expected) {
+		t.Fatalf("sand wedge from a bunker = %v, want %v", got, expected)
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("sand wedge from a bunker = %v, want %v", got, expected)
+			break
+		}
+	}
+}
+
+func TestShotType_Apply(t *testing.T) {
+	sandWedge := clubNamed("SW")
+
+	if full := FullSwing.Apply(sandWedge); full != sandWedge {
+		t.Errorf("a full swing should not change the club, got %+v", full)
+	}
+
+	chip := Chip.Apply(sandWedge)
+	if chip.Distance >= sandWedge.Distance/2 {
+		t.Errorf("a chip should travel well short of a full swing, got %v yards", chip.Distance)
+	}
+	if flop := Flop.Apply(sandWedge); flop.Loft <= sandWedge.Loft {
+		t.Errorf("a flop should open the face, got loft %v", flop.Loft)
+	}
+	if punch := Punch.Apply(clubNamed("4 Iron")); punch.Loft >= clubNamed("4 Iron").Loft {
+		t.Errorf("a punch should deloft the club, got loft %v", punch.Loft)
+	}
+	if punch := Punch.Apply(Club{Name: "4 Iron", Loft: 8}); punch.Loft != minimumLoft {
+		t.Errorf("a delofted club should bottom out at %v degrees, got %v", minimumLoft, punch.Loft)
+	}
+}
+
+func TestShotType_CarryFraction(t *testing.T) {
+	wedge := clubNamed("PW")
+
+	if FullSwing.CarryFraction(wedge) != wedge.CarryFraction() {
+		t.Error("a full swing should carry the club's own share")
+	}
+	if Chip.CarryFraction(wedge) >= Pitch.CarryFraction(wedge) || Pitch.CarryFraction(wedge) >= Flop.CarryFraction(wedge) {
+		t.Error("chips should roll more than pitches, and pitches more than flops")
+	}
+	if Chip.CarryFraction(clubNamed("Putter")) != 0 {
+		t.Error("a putt never leaves the ground")
+	}
+}
+
+func TestShotType_DifficultyModifier(t *testing.T) {
+	if Chip.DifficultyModifier(Fairway) <= FullSwing.DifficultyModifier(Fairway) {
+		t.Error("a chip should be easier than a full swing")
+	}
+	if Flop.DifficultyModifier(Fairway) >= Pitch.DifficultyModifier(Fairway) {
+		t.Error("a flop should be harder than a pitch")
+	}
+	if Flop.DifficultyModifier(Rough) <= Flop.DifficultyModifier(Fairway) {
+		t.Error("a flop should be easier from a fluffy lie")
+	}
+	if Bunker.DifficultyModifier()+Splash.DifficultyModifier(Bunker) <= Bunker.DifficultyModifier() {
+		t.Error("the splash should ease a bunker shot")
+	}
+	if Punch.UnderCoverModifier() != 0 || FullSwing.UnderCoverModifier() >= 0 {
+		t.Error("only a punch should escape from under cover without a penalty")
+	}
+}
+
+func TestCalculateRotationWithType(t *testing.T) {
+	club := clubNamed("SW")
+	result := SkillCheckResult{Outcome: Marginal, Margin: 0}
+
+	full := CalculateRotationWithType(club, result, FullSwing, rand.New(rand.NewPCG(1, 2)))
+	chip := CalculateRotationWithType(club, result, Chip, rand.New(rand.NewPCG(1, 2)))
+	flop := CalculateRotationWithType(club, result, Flop, rand.New(rand.NewPCG(1, 2)))
+
+	if full != CalculateRotation(club, result, rand.New(rand.NewPCG(1, 2))) {
+		t.Error("a full swing should rotate exactly as CalculateRotation")
+	}
+	if !(chip < full && full < flop) {
+		t.Errorf("expected chip < full < flop rotation, got %.2f, %.2f, %.2f", chip, full, flop)
+	}
+}
+
+func TestCalculatePowerWithType(t *testing.T) {
+	club := clubNamed("SW")
+	result := SkillCheckResult{Outcome: Poor, Margin: -2}
+
+	full := CalculatePowerWithType(club, 1.0, result, FullSwing)
+	chip := CalculatePowerWithType(club, 1.0, result, Chip)
+	flop := CalculatePowerWithType(club, 1.0, result, Flop)
+
+	if full != CalculatePower(club, 1.0, result) {
+		t.Error("a full swing should keep exactly the power from CalculatePower")
+	}
+	if !(chip > full && full > flop) {
+		t.Errorf("a mishit should cost a chip least and a flop most, got chip %.2f, full %.2f, flop %.2f", chip, full, flop)
+	}
+	if good := CalculatePowerWithType(club, 0.8, SkillCheckResult{Outcome: Good, Margin: 4}, Flop); good != 0.8 {
+		t.Errorf("a well struck flop should keep its power, got %.2f", good)
+	}
+}
@@ -0,0 +1,147 @@
+package gogolf
+
+import (
+	"math"
+	"sort"
+)
+
+// BaselinePoint is the average number of strokes taken to hole out from a distance
+type BaselinePoint struct {
+	Distance Yard
+	Strokes  float64
+}
+
+// Baseline is an expected-strokes table: for each lie, the strokes an average golfer of some
+// standard takes to hole out from a range of distances, nearest first
+type Baseline map[LieType][]BaselinePoint
+
+// ExpectedStrokes is how many strokes it should take to hole out from distance with lie,
+// interpolated between the table's distances. A lie with no row of its own, such as a penalty
+// area, is treated as deep rough.
+func (b Baseline) ExpectedStrokes(lie LieType, distance Yard) float64 {
+	row, ok := b[lie]
+	if !ok {
+		row = b[DeepRough]
+	}
+	if len(row) == 0 {
+		return 0
+	}
+	i := sort.Search(len(row), func(i int) bool { return row[i].Distance >= distance })
+	if i == 0 || len(row) == 1 {
+		return row[0].Strokes
+	}
+	if i == len(row) {
+		// past the longest distance carry on at the rate of the last two
+		i = len(row) - 1
+	}
+	near, far := row[i-1], row[i]
+	t := float64((distance - near.Distance) / (far.Distance - near.Distance))
+	return near.Strokes + (far.Strokes-near.Strokes)*t
+}
+
+// ShotCategory groups shots the way strokes gained is reported
+type ShotCategory int
+
+const (
+	OffTheTee ShotCategory = iota
+	Approach
+	AroundTheGreen
+	Putting
+)
+
+func (c ShotCategory) String() string {
+	return [...]string{
+		"Off the Tee",
+		"Approach",
+		"Around the Green",
+		"Putting",
+	}[c]
+}
+
+// ShotCategories lists every category in the order a hole is played
+func ShotCategories() []ShotCategory {
+	return []ShotCategory{OffTheTee, Approach, AroundTheGreen, Putting}
+}
+
+// aroundTheGreenRange is how close to the hole a shot from off the green counts as around the green
+const aroundTheGreenRange = Yard(30)
+
+// categorize places a hole's shot: the tee shot on a par 4 or 5 is off the tee, a shot on the green
+// is putting, one from close to the green is around the green and everything else is an approach
+func categorize(h Hole, first bool, shot ShotRecord) ShotCategory {
+	switch {
+	case first && shot.StartLie == Tee && h.Par >= 4:
+		return OffTheTee
+	case shot.StartLie == Green:
+		return Putting
+	case shot.FromHole <= aroundTheGreenRange:
+		return AroundTheGreen
+	default:
+		return Approach
+	}
+}
+
+// StrokesGained is how many strokes a round's shots gained on the baseline, in total for each
+// category and for each skill the shots were played with. Negative values are strokes lost.
+type StrokesGained struct {
+	ByCategory map[ShotCategory]float64
+	BySkill    map[string]float64
+}
+
+// Total is the strokes gained over the whole round
+func (sg StrokesGained) Total() (total float64) {
+	for _, gained := range sg.ByCategory {
+		total += gained
+	}
+	return
+}
+
+// WeakestSkill is the skill whose shots lost the most strokes, the one most worth training
+func (sg StrokesGained) WeakestSkill() (skill string, gained float64) {
+	gained = math.Inf(1)
+	for name, value := range sg.BySkill {
+		if value < gained || (value == gained && name < skill) {
+			skill, gained = name, value
+		}
+	}
+	if skill == "" {
+		return "", 0
+	}
+	return skill, gained
+}
+
+// ShotGained is what a shot gained on the baseline: the strokes expected from where it started,
+// less those expected from where the next one was played, less the stroke and any penalties.
+// next is nil for the last shot on a hole.
+func (b Baseline) ShotGained(shot ShotRecord, next *ShotRecord) float64 {
+	after := 0.0
+	switch {
+	case next != nil:
+		after = b.ExpectedStrokes(next.StartLie, next.FromHole)
+	case !shot.HoledOut:
+		after = b.ExpectedStrokes(shot.EndLie, shot.ToHole)
+	}
+	return b.ExpectedStrokes(shot.StartLie, shot.FromHole) - after - 1 - float64(shot.Penalties)
+}
+
+// Analyze breaks down the strokes gained by every shot recorded on the card
+func (b Baseline) Analyze(card ScoreCard) StrokesGained {
+	sg := StrokesGained{ByCategory: map[ShotCategory]float64{}, BySkill: map[string]float64{}}
+	for _, category := range ShotCategories() {
+		sg.ByCategory[category] = 0
+	}
+	golfer := NewGolfer("")
+	for _, hole := range card.Course.Holes {
+		shots := card.ShotsThisHole(hole)
+		for i, shot := range shots {
+			var next *ShotRecord
+			if i+1 < len(shots) {
+				next = &shots[i+1]
+			}
+			gained := b.ShotGained(shot, next)
+			sg.ByCategory[categorize(hole, i == 0, shot)] += gained
+			sg.BySkill[golfer.GetSkillForClub(Club{Name: shot.Club}).Name] += gained
+		}
+	}
+	return sg
+}
@@ -0,0 +1,101 @@
+package gogolf
+
+import (
+	"math"
+	"testing"
+)
+
+var testBaseline = Baseline{
+	Tee:       {{Distance: 300, Strokes: 3.8}, {Distance: 400, Strokes: 4.0}},
+	Fairway:   {{Distance: 50, Strokes: 2.6}, {Distance: 150, Strokes: 2.9}},
+	Rough:     {{Distance: 50, Strokes: 2.8}, {Distance: 150, Strokes: 3.2}},
+	DeepRough: {{Distance: 50, Strokes: 3.0}, {Distance: 150, Strokes: 3.5}},
+	Bunker:    {{Distance: 10, Strokes: 2.4}, {Distance: 50, Strokes: 2.8}},
+	Green:     {{Distance: 1, Strokes: 1.0}, {Distance: 10, Strokes: 1.6}},
+}
+
+func closeTo(got, want float64) bool {
+	return math.Abs(got-want) < 1e-6
+}
+
+func TestExpectedStrokesInterpolates(t *testing.T) {
+	cases := []struct {
+		lie      LieType
+		distance Yard
+		want     float64
+	}{
+		{Fairway, 100, 2.75},
+		{Fairway, 50, 2.6},
+		{Fairway, 10, 2.6},
+		{Fairway, 250, 3.2},
+		{PenaltyArea, 150, 3.5},
+	}
+	for _, c := range cases {
+		if got := testBaseline.ExpectedStrokes(c.lie, c.distance); !closeTo(got, c.want) {
+			t.Errorf("%s from %.0f: expected %.2f strokes, got %.2f", c.lie, c.distance, c.want, got)
+		}
+	}
+}
+
+func TestShotGained(t *testing.T) {
+	approach := ShotRecord{StartLie: Fairway, EndLie: Green, FromHole: 150, ToHole: 1}
+	if got := testBaseline.ShotGained(approach, nil); !closeTo(got, 0.9) {
+		t.Errorf("an approach to a yard should gain 0.9, got %.2f", got)
+	}
+
+	holed := ShotRecord{StartLie: Green, EndLie: Green, FromHole: 10, HoledOut: true}
+	if got := testBaseline.ShotGained(holed, nil); !closeTo(got, 0.6) {
+		t.Errorf("holing from 10 yards should gain 0.6, got %.2f", got)
+	}
+
+	// out of bounds: the next shot is played again from where this one started
+	lost := ShotRecord{StartLie: Tee, EndLie: Tee, FromHole: 400, ToHole: 400, Penalties: 1}
+	next := ShotRecord{StartLie: Tee, FromHole: 400}
+	if got := testBaseline.ShotGained(lost, &next); !closeTo(got, -2) {
+		t.Errorf("stroke and distance should lose 2, got %.2f", got)
+	}
+}
+
+func TestAnalyzeByCategoryAndSkill(t *testing.T) {
+	hole := Hole{Number: 1, Par: 4}
+	sc := NewScoreCard(Course{Holes: []Hole{hole}})
+	playHole(&sc, hole,
+		ShotRecord{Club: "Driver", StartLie: Tee, EndLie: Rough, FromHole: 400, ToHole: 150},
+		ShotRecord{Club: "7 Iron", StartLie: Rough, EndLie: Bunker, FromHole: 150, ToHole: 10},
+		ShotRecord{Club: "SW", StartLie: Bunker, EndLie: Green, FromHole: 10, ToHole: 5},
+		ShotRecord{Club: "Putter", StartLie: Green, EndLie: Green, FromHole: 5, ToHole: 1, Putt: true},
+		ShotRecord{Club: "Putter", StartLie: Green, EndLie: Green, FromHole: 1, Putt: true, HoledOut: true},
+	)
+
+	sg := testBaseline.Analyze(sc)
+
+	fiveYards := 1.0 + 0.6*4/9
+	want := map[ShotCategory]float64{OffTheTee: -0.2, Approach: -0.2, AroundTheGreen: 2.4 - fiveYards - 1, Putting: fiveYards - 2}
+	for category, gained := range want {
+		if !closeTo(sg.ByCategory[category], gained) {
+			t.Errorf("%s: expected %+.1f, got %+.2f", category, gained, sg.ByCategory[category])
+		}
+	}
+	if !closeTo(sg.Total(), 4.0-5) {
+		t.Errorf("a bogey from an expected 4.0 should lose a stroke in total, got %+.2f", sg.Total())
+	}
+	if skill, gained := sg.WeakestSkill(); skill != "Putter" || !closeTo(gained, fiveYards-2) {
+		t.Errorf("expected the three putt to make the putter the weakest, got %s %+.2f", skill, gained)
+	}
+}
+
+func TestCategorizeParThreeTeeShotIsAnApproach(t *testing.T) {
+	shot := ShotRecord{StartLie: Tee, FromHole: 160}
+	if got := categorize(Hole{Par: 3}, true, shot); got != Approach {
+		t.Errorf("a par 3 tee shot is an approach, got %s", got)
+	}
+	if got := categorize(Hole{Par: 4}, true, shot); got != OffTheTee {
+		t.Errorf("a par 4 tee shot is off the tee, got %s", got)
+	}
+}
+
+func TestWeakestSkillWithNoShots(t *testing.T) {
+	if skill, gained := (StrokesGained{}).WeakestSkill(); skill != "" || gained != 0 {
+		t.Errorf("expected no weakest skill, got %s %+.2f", skill, gained)
+	}
+}
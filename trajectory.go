@@ -0,0 +1,151 @@
+package gogolf
+
+import "math"
+
+// Trajectory is how high the golfer tries to flight the ball
+type Trajectory int
+
+const (
+	StandardTrajectory Trajectory = iota
+	LowTrajectory
+	HighTrajectory
+)
+
+func (t Trajectory) String() string {
+	return [...]string{
+		"Standard",
+		"Low",
+		"High",
+	}[t]
+}
+
+// trajectoryLoft is how many degrees the golfer adds or takes off the club to flight the ball
+const trajectoryLoft = 5
+
+// Apply returns club with its loft changed to flight the ball low or high
+func (t Trajectory) Apply(club Club) Club {
+	switch t {
+	case LowTrajectory:
+		club.Loft = float32(math.Max(float64(club.Loft-trajectoryLoft), minimumLoft))
+	case HighTrajectory:
+		club.Loft += trajectoryLoft
+	}
+	return club
+}
+
+// CarryFraction adjusts the share of a shot's distance flown in the air: a low ball
+// lands sooner and runs, a high one carries further and drops softly
+func (t Trajectory) CarryFraction(carry float64) float64 {
+	if carry == 0 {
+		return 0
+	}
+	switch t {
+	case LowTrajectory:
+		return carry * 0.85
+	case HighTrajectory:
+		return math.Min(carry+0.04, 0.98)
+	default:
+		return carry
+	}
+}
+
+// DifficultyModifier is added to the target number for flighting the ball from lie.
+// Trapping the ball low takes the grass out of the strike and eases a bad lie,
+// while getting it up quickly out of long grass or sand makes a bad lie worse.
+func (t Trajectory) DifficultyModifier(lie LieType) int {
+	badLie := lie == Rough || lie == DeepRough || lie == Bunker
+	switch t {
+	case LowTrajectory:
+		if badLie {
+			return 1
+		}
+		return -1
+	case HighTrajectory:
+		if badLie {
+			return -2
+		}
+		return -1
+	default:
+		return 0
+	}
+}
+
+// Spin is how much spin the golfer tries to put on the ball
+type Spin int
+
+const (
+	Check Spin = iota
+	Backspin
+	Release
+)
+
+func (s Spin) String() string {
+	return [...]string{
+		"Check",
+		"Backspin",
+		"Release",
+	}[s]
+}
+
+// multiplier scales the spin a ball takes from a strike
+func (s Spin) multiplier() float64 {
+	switch s {
+	case Backspin:
+		return 1.6
+	case Release:
+		return 0.3
+	default:
+		return 1
+	}
+}
+
+// SpinOutcome is what the ball's spin did when it landed
+type SpinOutcome int
+
+const (
+	NoSpinEffect SpinOutcome = iota
+	Released
+	CheckedUp
+	SpunBack
+)
+
+func (o SpinOutcome) String() string {
+	return [...]string{
+		"None",
+		"Released",
+		"Checked Up",
+		"Spun Back",
+	}[o]
+}
+
+// RollFactorWithSpin scales the roll-out of a shot that lands on lie with the spin the golfer played.
+// Spin only takes hold on closely mown grass: there a ball hit with enough of it checks up,
+// and a lofted backspin shot with a ball that holds spin can draw back toward the golfer,
+// shown by a negative factor. A ball that holds spin (see Ball.SpinControl) checks up more,
+// and more so from lofted clubs that put more spin on it.
+func RollFactorWithSpin(lie LieType, club Club, spinControl float32, spin Spin) (float64, SpinOutcome) {
+	base := RollFactor(lie)
+	if base == 0 {
+		return 0, NoSpinEffect
+	}
+
+	amount := float64(spinControl) * math.Min(float64(club.Loft)/60, 1) * spin.multiplier()
+	var factor float64
+	switch lie {
+	case Green:
+		factor = base * (1 - 0.8*amount)
+	case Tee, Fairway, FirstCut:
+		factor = base * (1 - 0.4*amount)
+	default:
+		return base, NoSpinEffect
+	}
+
+	switch {
+	case factor < 0:
+		return math.Max(factor, -0.5), SpunBack
+	case factor < base*0.6:
+		return factor, CheckedUp
+	default:
+		return factor, Released
+	}
+}
@@ -0,0 +1,97 @@
+package gogolf
+
+import "testing"
+
+func TestTrajectory_ApplyChangesLoft(t *testing.T) {
+	sevenIron := clubNamed("7 Iron")
+
+	if low := LowTrajectory.Apply(sevenIron); low.Loft != sevenIron.Loft-trajectoryLoft {
+		t.Errorf("low 7 iron loft = %v, want %v", low.Loft, sevenIron.Loft-trajectoryLoft)
+	}
+	if high := HighTrajectory.Apply(sevenIron); high.Loft != sevenIron.Loft+trajectoryLoft {
+		t.Errorf("high 7 iron loft = %v, want %v", high.Loft, sevenIron.Loft+trajectoryLoft)
+	}
+	if low := LowTrajectory.Apply(Club{Loft: 4}); low.Loft != minimumLoft {
+		t.Errorf("low trajectory should not deloft below %v, got %v", minimumLoft, low.Loft)
+	}
+
+	flat := clubNamed("7 Iron").ApexHeight(150)
+	if LowTrajectory.Apply(sevenIron).ApexHeight(150) >= flat || HighTrajectory.Apply(sevenIron).ApexHeight(150) <= flat {
+		t.Error("a low ball should peak below a standard one and a high ball above it")
+	}
+}
+
+func TestTrajectory_CarryFraction(t *testing.T) {
+	if got := StandardTrajectory.CarryFraction(0.9); got != 0.9 {
+		t.Errorf("standard carry = %v, want 0.9", got)
+	}
+	if got := LowTrajectory.CarryFraction(0.9); got >= 0.9 {
+		t.Errorf("a low ball should land sooner, got %v", got)
+	}
+	if got := HighTrajectory.CarryFraction(0.96); got != 0.98 {
+		t.Errorf("high carry = %v, want capped at 0.98", got)
+	}
+	if got := HighTrajectory.CarryFraction(0); got != 0 {
+		t.Errorf("a putt should stay on the ground, got %v", got)
+	}
+}
+
+func TestTrajectory_DifficultyModifier(t *testing.T) {
+	tests := []struct {
+		trajectory Trajectory
+		lie        LieType
+		expected   int
+	}{
+		{StandardTrajectory, Rough, 0},
+		{LowTrajectory, Fairway, -1},
+		{LowTrajectory, DeepRough, 1},
+		{HighTrajectory, Fairway, -1},
+		{HighTrajectory, Rough, -2},
+		{HighTrajectory, Bunker, -2},
+	}
+
+	for _, tt := range tests {
+		if got := tt.trajectory.DifficultyModifier(tt.lie); got != tt.expected {
+			t.Errorf("%s.DifficultyModifier(%s) = %d, want %d", tt.trajectory, tt.lie, got, tt.expected)
+		}
+	}
+}
+
+func TestRollFactorWithSpin(t *testing.T) {
+	wedge := clubNamed("LW")
+
+	tests := []struct {
+		lie         LieType
+		spinControl float32
+		spin        Spin
+		expected    SpinOutcome
+	}{
+		{Green, 0.9, Backspin, SpunBack},
+		{Green, 0.9, Check, CheckedUp},
+		{Green, 0.9, Release, Released},
+		{Green, 0, Backspin, Released},
+		{Fairway, 0.9, Backspin, CheckedUp},
+		{Rough, 0.9, Backspin, NoSpinEffect},
+		{Bunker, 0.9, Backspin, NoSpinEffect},
+	}
+
+	for _, tt := range tests {
+		factor, outcome := RollFactorWithSpin(tt.lie, wedge, tt.spinControl, tt.spin)
+		if outcome != tt.expected {
+			t.Errorf("RollFactorWithSpin(%s, %.1f, %s) outcome = %s, want %s", tt.lie, tt.spinControl, tt.spin, outcome, tt.expected)
+		}
+		if outcome == SpunBack && factor >= 0 {
+			t.Errorf("a ball that spins back should have a negative roll factor, got %v", factor)
+		}
+	}
+}
+
+func TestRollFactorWithSpin_NoSpinControlMatchesRollFactor(t *testing.T) {
+	wedge := clubNamed("SW")
+	for _, lie := range []LieType{Tee, Fairway, FirstCut, Rough, DeepRough, Bunker, Green} {
+		factor, _ := RollFactorWithSpin(lie, wedge, 0, Check)
+		if expected := RollFactor(lie); factor != expected {
+			t.Errorf("a ball with no spin control from %s = %v, want RollFactor's %v", lie, factor, expected)
+		}
+	}
+}
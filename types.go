@@ -1,5 +1,10 @@
 package gogolf
 
+import (
+	"fmt"
+	"strings"
+)
+
 type LieType int
 
 const (
@@ -26,6 +31,22 @@ func (l LieType) String() string {
 	}[l]
 }
 
+// ParseLieType converts a lie name such as "fairway" or "first_cut" into a LieType
+func ParseLieType(name string) (LieType, error) {
+	normalized := normalizeName(name)
+	for lie := Tee; lie <= PenaltyArea; lie++ {
+		if normalizeName(lie.String()) == normalized {
+			return lie, nil
+		}
+	}
+	return Fairway, fmt.Errorf("unknown lie %q", name)
+}
+
+func normalizeName(name string) string {
+	replacer := strings.NewReplacer(" ", "", "_", "", "-", "")
+	return strings.ToLower(replacer.Replace(name))
+}
+
 func (l LieType) DifficultyModifier() int {
 	switch l {
 	case Tee:
@@ -25,6 +25,25 @@ type GameState struct {
 	HoleLocationX     float64
 	HoleLocationY     float64
 
+	// Terrain
+	PlaysLike        float64 // yards to the hole allowing for elevation
+	ElevationChange  float64 // feet the hole sits above (+) or below (-) the ball
+	Stance           string
+	StanceDifficulty int
+
+	// Weather (only shown when HasWeather is set)
+	HasWeather   bool
+	WindSpeed    float64 // mph
+	WindGust     float64 // mph a gust can reach
+	WindRelative float64 // degrees relative to the line to the hole: 0 helping, 180 into the face
+	Temperature  float64 // Fahrenheit
+	Rain         string
+
+	// Green read (Stimp is zero when the ball is not on the green)
+	BreakFeet float64 // positive breaks right
+	Grade     float64 // percent, positive uphill
+	Stimp     float64
+
 	// Last shot (nil if no shot yet)
 	LastShot *ShotDisplay
 
@@ -32,6 +51,13 @@ type GameState struct {
 	TotalStrokes     int
 	ScoreToPar       int
 	StrokesThisHole  int
+	ScoringFormat    string // e.g. "Stableford"; empty for stroke play
+	Points           string // result in ScoringFormat, e.g. "12 pts"
+
+	// Hot-seat round (Leaderboard is empty when playing alone)
+	Turn        string // why PlayerName is up, e.g. "honour" or "away"
+	Leaderboard []LeaderboardEntry
+	MatchStatus string // e.g. "Alice 2 UP with 3 to play"; empty in stroke play
 
 	// Messages
 	StatusMsg string
@@ -39,6 +65,17 @@ type GameState struct {
 	PromptMsg string
 }
 
+// LeaderboardEntry is one golfer's place in a hot-seat round
+type LeaderboardEntry struct {
+	Name       string
+	Strokes    int
+	ScoreToPar int
+	Thru       int
+	PickedUp   int    // holes abandoned without holing out
+	Points     string // result in the scoring format, e.g. "12 pts"; empty for stroke play
+	Current    bool   // whose turn it is
+}
+
 // SkillDisplay represents skill information for display
 type SkillDisplay struct {
 	Name       string
@@ -70,6 +107,7 @@ type EquipmentDisplay struct {
 // ShotDisplay represents the result of a shot for display
 type ShotDisplay struct {
 	ClubName      string
+	ShotType      string // e.g. "Chip"; empty for a full swing
 	IntendedShape string
 	ActualShape   string
 	ShapeSuccess  bool
@@ -80,8 +118,17 @@ type ShotDisplay struct {
 	Description   string  // Quality description
 	Rotation      float64 // Degrees
 	RotationDir   string  // "left" or "right"
+	Aim           string  // Name of the aim point, e.g. "Center Fairway"
+	OffLine       float64 // Yards right (positive) or left (negative) of the aim line
 	Power         float64 // Percentage 0-1
+	Carry         float64 // Yards in the air
+	Roll          float64 // Yards rolled after landing
 	Distance      float64 // Yards traveled
+	Trajectory    string  // "Low" or "High"; empty for a standard flight
+	Apex          float64 // Feet the ball climbed
+	SpinOutcome   string  // e.g. "Checked Up"; empty when spin had no effect
+	Obstacle      string  // What the ball struck, e.g. "Big oak (Ricochet)"; empty if nothing
+	Penalty       string  // Penalty incurred, e.g. "Out of Bounds, stroke and distance (+1)"; empty if none
 	XPEarned      int
 	LevelUps      []string // e.g., ["Driver: Level 2!", "Strength: Level 3!"]
 }
@@ -18,6 +18,7 @@ type PowerMeter struct {
 	isPutting        bool
 	puttDistanceFeet float64
 	putterMaxYards   float64 // Putter's max distance in yards for power conversion
+	elevationYards   float64 // Yards the target sits above the ball; shortens the projected distance
 }
 
 // NewPowerMeter creates a power meter with default settings
@@ -184,6 +185,12 @@ func (pm *PowerMeter) SetClubDistance(distance float64) {
 	pm.isPutting = false
 }
 
+// SetElevationChange tells the meter how many yards above (positive) or below the ball the target sits,
+// so the projected distance is how far the ball travels over the ground
+func (pm *PowerMeter) SetElevationChange(yards float64) {
+	pm.elevationYards = yards
+}
+
 // SetPuttingMode configures the meter for putting with auto-scaled distance
 // clubMaxDistanceYards is the putter's max distance in yards (used for power calculation)
 func (pm *PowerMeter) SetPuttingMode(distanceFeet float64) {
@@ -211,7 +218,10 @@ func (pm *PowerMeter) ClearPuttingMode() {
 
 // calculateProjectedDistance returns the projected shot distance based on power
 func (pm *PowerMeter) calculateProjectedDistance(power float64) float64 {
-	return pm.clubMaxDistance * power
+	if pm.isPutting {
+		return pm.clubMaxDistance * power
+	}
+	return float64(gogolf.GroundDistance(gogolf.Yard(pm.clubMaxDistance*power), gogolf.Yard(pm.elevationYards).Feet()))
 }
 
 // formatDistanceDisplay creates a string showing power percentage and projected distance
@@ -264,6 +274,171 @@ func (s *ShotShapeSelector) SelectShotShape() gogolf.ShotShape {
 	}
 }
 
+// ShotTypeSelector lets the golfer choose between a full swing and the short-game shots
+type ShotTypeSelector struct {
+	renderer *Renderer
+}
+
+// NewShotTypeSelector creates a shot type selector
+func NewShotTypeSelector(renderer *Renderer) *ShotTypeSelector {
+	return &ShotTypeSelector{renderer: renderer}
+}
+
+// SelectShotType lists the available shot types and returns the one chosen.
+// Default is the first type if user just presses Enter or space
+func (s *ShotTypeSelector) SelectShotType(types []gogolf.ShotType) gogolf.ShotType {
+	panel := s.renderer.Layout.LeftPanel
+	row := panel.Height - 5
+
+	s.renderer.Terminal.MoveCursor(row, panel.X+2)
+	fmt.Printf("%-56s", formatShotTypeChoices(types))
+	s.renderer.Terminal.MoveCursor(row+1, panel.X+2)
+	fmt.Printf("%-56s", fmt.Sprintf("Press 1-%d or Enter for %s:", len(types), types[0]))
+
+	choice := 0
+	for {
+		key := readSingleKey()
+		if key == ' ' || key == '\r' || key == '\n' {
+			break
+		}
+		if key >= '1' && int(key-'1') < len(types) {
+			choice = int(key - '1')
+			break
+		}
+	}
+
+	s.renderer.Terminal.MoveCursor(row, panel.X+2)
+	fmt.Print("                                                        ")
+	s.renderer.Terminal.MoveCursor(row+1, panel.X+2)
+	fmt.Print("                                                        ")
+	return types[choice]
+}
+
+// formatShotTypeChoices lists shot types with the keys that pick them, e.g. "Shot: [1]Full Swing [2]Chip"
+func formatShotTypeChoices(types []gogolf.ShotType) string {
+	choices := "Shot:"
+	for i, shotType := range types {
+		choices += fmt.Sprintf(" [%d]%s", i+1, shotType)
+	}
+	return choices
+}
+
+// FlightSelector lets the golfer flight the ball low or high and choose how much spin to play
+type FlightSelector struct {
+	renderer *Renderer
+}
+
+// NewFlightSelector creates a flight selector
+func NewFlightSelector(renderer *Renderer) *FlightSelector {
+	return &FlightSelector{renderer: renderer}
+}
+
+// SelectFlight returns the trajectory and spin chosen with L/S/H and B/C/R.
+// Enter or space plays the current choice, which starts as a standard flight with check spin.
+func (s *FlightSelector) SelectFlight() (gogolf.Trajectory, gogolf.Spin) {
+	panel := s.renderer.Layout.LeftPanel
+	row := panel.Height - 5
+	trajectory, spin := gogolf.StandardTrajectory, gogolf.Check
+
+	s.renderer.Terminal.MoveCursor(row, panel.X+2)
+	fmt.Printf("%-56s", "Low/Std/High [L/S/H]  Spin: Back/Check/Release [B/C/R]")
+	for {
+		s.renderer.Terminal.MoveCursor(row+1, panel.X+2)
+		fmt.Printf("%-56s", formatFlightChoice(trajectory, spin))
+
+		key := readSingleKey()
+		if key == ' ' || key == '\r' || key == '\n' {
+			break
+		}
+		switch key {
+		case 'l', 'L':
+			trajectory = gogolf.LowTrajectory
+		case 's', 'S':
+			trajectory = gogolf.StandardTrajectory
+		case 'h', 'H':
+			trajectory = gogolf.HighTrajectory
+		case 'b', 'B':
+			spin = gogolf.Backspin
+		case 'c', 'C':
+			spin = gogolf.Check
+		case 'r', 'R':
+			spin = gogolf.Release
+		}
+	}
+
+	s.renderer.Terminal.MoveCursor(row, panel.X+2)
+	fmt.Print("                                                        ")
+	s.renderer.Terminal.MoveCursor(row+1, panel.X+2)
+	fmt.Print("                                                        ")
+	return trajectory, spin
+}
+
+// formatFlightChoice shows the flight about to be played, e.g. "Low trajectory, Backspin - Enter to play"
+func formatFlightChoice(trajectory gogolf.Trajectory, spin gogolf.Spin) string {
+	return fmt.Sprintf("%s trajectory, %s - Enter to play", trajectory, spin)
+}
+
+// Concession is what the opponent offers before a shot in match play
+type Concession int
+
+const (
+	PlayOn Concession = iota
+	GivePutt
+	ConcedeHole
+)
+
+// ConcessionSelector lets the opponent give a putt or concede the hole before a match play shot
+type ConcessionSelector struct {
+	renderer *Renderer
+}
+
+// NewConcessionSelector creates a concession selector
+func NewConcessionSelector(renderer *Renderer) *ConcessionSelector {
+	return &ConcessionSelector{renderer: renderer}
+}
+
+// SelectConcession asks the opponent whether to let the shot be played. Putts can only be
+// given when the ball is on the green. Default is to play on if user just presses Enter or space
+func (s *ConcessionSelector) SelectConcession(onGreen bool) Concession {
+	panel := s.renderer.Layout.LeftPanel
+	row := panel.Height - 5
+
+	s.renderer.Terminal.MoveCursor(row, panel.X+2)
+	fmt.Printf("%-56s", formatConcessionChoices(onGreen))
+	s.renderer.Terminal.MoveCursor(row+1, panel.X+2)
+	fmt.Printf("%-56s", "Opponent: press a key or Enter to play on:")
+
+	choice := PlayOn
+	for {
+		key := readSingleKey()
+		if key == ' ' || key == '\r' || key == '\n' {
+			break
+		}
+		if (key == 'g' || key == 'G') && onGreen {
+			choice = GivePutt
+			break
+		}
+		if key == 'c' || key == 'C' {
+			choice = ConcedeHole
+			break
+		}
+	}
+
+	s.renderer.Terminal.MoveCursor(row, panel.X+2)
+	fmt.Print("                                                        ")
+	s.renderer.Terminal.MoveCursor(row+1, panel.X+2)
+	fmt.Print("                                                        ")
+	return choice
+}
+
+// formatConcessionChoices lists what the opponent can do, e.g. "[Enter]Play on [G]Give putt [C]Concede hole"
+func formatConcessionChoices(onGreen bool) string {
+	if onGreen {
+		return "[Enter]Play on [G]Give putt [C]Concede hole"
+	}
+	return "[Enter]Play on [C]Concede hole"
+}
+
 // waitForShapeKey waits for a valid shape selection key
 func (s *ShotShapeSelector) waitForShapeKey() byte {
 	for {
@@ -277,6 +452,243 @@ func (s *ShotShapeSelector) waitForShapeKey() byte {
 	}
 }
 
+// ClubPicker lets the golfer cycle through the bag before a swing
+type ClubPicker struct {
+	renderer *Renderer
+	// Suspendable offers X to save the round and quit instead of choosing a club
+	Suspendable bool
+}
+
+// SuspendRound is what SelectClub returns when the golfer chooses to save the round and quit
+const SuspendRound = -1
+
+// NewClubPicker creates a club picker
+func NewClubPicker(renderer *Renderer) *ClubPicker {
+	return &ClubPicker{renderer: renderer}
+}
+
+// SelectClub shows one club at a time, starting at current, and returns the index chosen.
+// Q/E (or ,/.) cycle through the bag and Enter or space confirms. When the picker is
+// Suspendable, X returns SuspendRound.
+func (p *ClubPicker) SelectClub(clubs []string, current int) int {
+	panel := p.renderer.Layout.LeftPanel
+	row := panel.Height - 5
+	selected := current
+	help := "Q/E to change club, Enter to confirm"
+	if p.Suspendable {
+		help += ", X to save and quit"
+	}
+
+	for {
+		p.renderer.Terminal.MoveCursor(row, panel.X+2)
+		fmt.Printf("%-56s", fmt.Sprintf("Club: %s", clubs[selected]))
+		p.renderer.Terminal.MoveCursor(row+1, panel.X+2)
+		fmt.Printf("%-56s", help)
+
+		key := readSingleKey()
+		switch key {
+		case ' ', '\r', '\n':
+			p.renderer.Terminal.MoveCursor(row, panel.X+2)
+			fmt.Print("                                                        ")
+			p.renderer.Terminal.MoveCursor(row+1, panel.X+2)
+			fmt.Print("                                                        ")
+			return selected
+		case 'x', 'X':
+			if p.Suspendable {
+				return SuspendRound
+			}
+		case 'q', 'Q', ',':
+			selected = cycleIndex(selected, -1, len(clubs))
+		case 'e', 'E', '.':
+			selected = cycleIndex(selected, 1, len(clubs))
+		}
+	}
+}
+
+// cycleIndex steps through count items, wrapping around at either end
+func cycleIndex(index, step, count int) int {
+	return ((index+step)%count + count) % count
+}
+
+// AimSelector lets the golfer pick a named aim point and fine tune its angle and distance
+type AimSelector struct {
+	renderer *Renderer
+}
+
+// NewAimSelector creates an aim selector
+func NewAimSelector(renderer *Renderer) *AimSelector {
+	return &AimSelector{renderer: renderer}
+}
+
+// aimAngleStep and aimDistanceStep are how far one key press adjusts the aim
+const (
+	aimAngleStep    = 2.0
+	aimDistanceStep = gogolf.Yard(5)
+)
+
+// SelectAim displays the aim points and returns the chosen aim.
+// Number keys pick an aim point, A/D swing it left/right, W/S lengthen/shorten the planned distance,
+// and Enter or space confirms. The first aim point is selected to start with.
+func (s *AimSelector) SelectAim(aims []gogolf.Aim, from gogolf.Point, clubDistance gogolf.Yard) gogolf.Aim {
+	panel := s.renderer.Layout.LeftPanel
+	row := panel.Height - 5
+
+	base := aims[0]
+	angle := 0.0
+	distance := gogolf.Yard(0)
+
+	for {
+		aim := base.Rotate(from, angle).WithDistance(distance)
+		planned := aim.PlannedDistance(gogolf.Club{Distance: clubDistance})
+
+		s.renderer.Terminal.MoveCursor(row, panel.X+2)
+		fmt.Printf("%-56s", formatAimChoices(aims))
+		s.renderer.Terminal.MoveCursor(row+1, panel.X+2)
+		fmt.Printf("%-56s", fmt.Sprintf("Aim: %s %s | %.0f yds [A/D W/S Enter]", base.Name, formatAngle(angle), planned))
+
+		key := readSingleKey()
+		switch {
+		case key == ' ' || key == '\r' || key == '\n':
+			s.renderer.Terminal.MoveCursor(row, panel.X+2)
+			fmt.Print("                                                        ")
+			s.renderer.Terminal.MoveCursor(row+1, panel.X+2)
+			fmt.Print("                                                        ")
+			return aim
+		case key >= '1' && int(key-'1') < len(aims):
+			base = aims[key-'1']
+			angle = 0
+			distance = 0
+		case key == 'a' || key == 'A':
+			angle -= aimAngleStep
+		case key == 'd' || key == 'D':
+			angle += aimAngleStep
+		case key == 'w' || key == 'W':
+			if distance > 0 {
+				distance += aimDistanceStep
+				if distance >= clubDistance {
+					distance = 0
+				}
+			}
+		case key == 's' || key == 'S':
+			if distance == 0 {
+				distance = clubDistance
+			}
+			distance = max(distance-aimDistanceStep, aimDistanceStep)
+		}
+	}
+}
+
+// formatAimChoices lists the aim points with the keys that select them
+func formatAimChoices(aims []gogolf.Aim) string {
+	choices := ""
+	for i, aim := range aims {
+		choices += fmt.Sprintf("[%d]%s ", i+1, aim.Name)
+	}
+	return choices
+}
+
+// formatAngle describes an aim adjustment, e.g. "4° right"
+func formatAngle(degrees float64) string {
+	switch {
+	case degrees > 0:
+		return fmt.Sprintf("%.0f° right", degrees)
+	case degrees < 0:
+		return fmt.Sprintf("%.0f° left", -degrees)
+	default:
+		return ""
+	}
+}
+
+// ReliefSelector asks how to proceed after a ball comes to rest in a penalty area
+type ReliefSelector struct {
+	renderer *Renderer
+}
+
+// NewReliefSelector creates a relief selector
+func NewReliefSelector(renderer *Renderer) *ReliefSelector {
+	return &ReliefSelector{renderer: renderer}
+}
+
+// SelectRelief lists the relief options and returns the index of the one chosen.
+// Default is the first option if user just presses Enter or space
+func (s *ReliefSelector) SelectRelief(options []string) int {
+	panel := s.renderer.Layout.LeftPanel
+	row := panel.Height - 5 - len(options)
+
+	s.renderer.Terminal.MoveCursor(row, panel.X+2)
+	fmt.Print("Penalty area! Take relief (+1 stroke):")
+	for i, option := range options {
+		s.renderer.Terminal.MoveCursor(row+1+i, panel.X+2)
+		fmt.Printf("[%d] %s", i+1, option)
+	}
+	s.renderer.Terminal.MoveCursor(row+1+len(options), panel.X+2)
+	fmt.Printf("Press 1-%d or Enter for option 1:", len(options))
+
+	choice := waitForOptionKey(len(options), 0)
+
+	for i := 0; i <= len(options)+1; i++ {
+		s.renderer.Terminal.MoveCursor(row+i, panel.X+2)
+		fmt.Print("                                                        ")
+	}
+	return choice
+}
+
+// BallSelector asks a scramble team which of its shots to play on from
+type BallSelector struct {
+	renderer *Renderer
+}
+
+// NewBallSelector creates a ball selector
+func NewBallSelector(renderer *Renderer) *BallSelector {
+	return &BallSelector{renderer: renderer}
+}
+
+// SelectBall lists each teammate's shot and returns the index of the one chosen.
+// Default is the suggested ball if user just presses Enter or space
+func (s *BallSelector) SelectBall(balls []string, suggested int) int {
+	panel := s.renderer.Layout.LeftPanel
+	row := panel.Height - 5 - len(balls)
+
+	s.renderer.Terminal.MoveCursor(row, panel.X+2)
+	fmt.Print("Scramble! Choose the ball to play:")
+	for i, ball := range balls {
+		s.renderer.Terminal.MoveCursor(row+1+i, panel.X+2)
+		fmt.Print(formatBallChoice(i, ball, suggested))
+	}
+	s.renderer.Terminal.MoveCursor(row+1+len(balls), panel.X+2)
+	fmt.Printf("Press 1-%d or Enter for ball %d:", len(balls), suggested+1)
+
+	choice := waitForOptionKey(len(balls), suggested)
+
+	for i := 0; i <= len(balls)+1; i++ {
+		s.renderer.Terminal.MoveCursor(row+i, panel.X+2)
+		fmt.Print("                                                        ")
+	}
+	return choice
+}
+
+// formatBallChoice labels a scramble ball, marking the suggested one
+func formatBallChoice(index int, ball string, suggested int) string {
+	label := fmt.Sprintf("[%d] %s", index+1, ball)
+	if index == suggested {
+		label += " (best)"
+	}
+	return label
+}
+
+// waitForOptionKey waits for a valid option key and returns its index, or defaultIndex for Enter or space
+func waitForOptionKey(count, defaultIndex int) int {
+	for {
+		key := readSingleKey()
+		if key == ' ' || key == '\r' || key == '\n' {
+			return defaultIndex
+		}
+		if key >= '1' && int(key-'1') < count {
+			return int(key - '1')
+		}
+	}
+}
+
 // DiceRoller displays an animated dice rolling effect
 type DiceRoller struct {
 	renderer *Renderer
@@ -326,6 +738,9 @@ func (dr *DiceRoller) ShowRoll(finalRolls []int, targetNumber int) {
 			displayed[2] = finalRolls[2]
 		}
 
+		// The tumbling faces are cosmetic and deliberately use the global source: how many frames
+		// are drawn depends on timing, so drawing them from the game's seeded source would make
+		// seeded rounds play out differently. The real roll is finalRolls.
 		for i := 0; i < 3; i++ {
 			if !stopped[i] {
 				displayed[i] = rand.Intn(6) + 1
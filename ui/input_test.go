@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"gogolf"
 	"math"
 	"testing"
 	"time"
@@ -182,6 +183,22 @@ func TestPowerMeter_CalculateProjectedDistance(t *testing.T) {
 	}
 }
 
+func TestPowerMeter_CalculateProjectedDistanceWithElevation(t *testing.T) {
+	renderer := NewRenderer()
+	pm := NewPowerMeter(renderer)
+	pm.SetClubDistance(200)
+
+	pm.SetElevationChange(10)
+	if distance := pm.calculateProjectedDistance(1.0); math.Abs(distance-190) > 0.01 {
+		t.Errorf("uphill projected distance = %.2f, want 190", distance)
+	}
+
+	pm.SetElevationChange(-10)
+	if distance := pm.calculateProjectedDistance(1.0); math.Abs(distance-210) > 0.01 {
+		t.Errorf("downhill projected distance = %.2f, want 210", distance)
+	}
+}
+
 func TestPowerMeter_FormatDistanceDisplay(t *testing.T) {
 	renderer := NewRenderer()
 	pm := NewPowerMeter(renderer)
@@ -276,3 +293,72 @@ func TestPowerMeter_SetPuttingModeWithClubDistance(t *testing.T) {
 		t.Error("isPutting should be true")
 	}
 }
+
+func TestFormatAngle(t *testing.T) {
+	tests := []struct {
+		degrees  float64
+		expected string
+	}{
+		{0, ""},
+		{4, "4° right"},
+		{-6, "6° left"},
+	}
+
+	for _, tt := range tests {
+		if got := formatAngle(tt.degrees); got != tt.expected {
+			t.Errorf("formatAngle(%v) = %q, want %q", tt.degrees, got, tt.expected)
+		}
+	}
+}
+
+func TestFormatAimChoices(t *testing.T) {
+	aims := []gogolf.Aim{gogolf.NewAim(gogolf.AimFlag, gogolf.Point{}), gogolf.NewAim(gogolf.AimCenterGreen, gogolf.Point{})}
+
+	if got := formatAimChoices(aims); got != "[1]Flag [2]Center Green " {
+		t.Errorf("formatAimChoices = %q", got)
+	}
+}
+
+func TestFormatShotTypeChoices(t *testing.T) {
+	types := []gogolf.ShotType{gogolf.FullSwing, gogolf.Chip, gogolf.Splash}
+
+	if got := formatShotTypeChoices(types); got != "Shot: [1]Full Swing [2]Chip [3]Bunker Splash" {
+		t.Errorf("formatShotTypeChoices = %q", got)
+	}
+}
+
+func TestFormatFlightChoice(t *testing.T) {
+	if got := formatFlightChoice(gogolf.LowTrajectory, gogolf.Backspin); got != "Low trajectory, Backspin - Enter to play" {
+		t.Errorf("formatFlightChoice = %q", got)
+	}
+}
+
+func TestFormatConcessionChoices(t *testing.T) {
+	if got := formatConcessionChoices(true); got != "[Enter]Play on [G]Give putt [C]Concede hole" {
+		t.Errorf("formatConcessionChoices on the green = %q", got)
+	}
+	if got := formatConcessionChoices(false); got != "[Enter]Play on [C]Concede hole" {
+		t.Errorf("formatConcessionChoices off the green = %q", got)
+	}
+}
+
+func TestFormatBallChoice(t *testing.T) {
+	if got := formatBallChoice(1, "Bob - Fairway, 142 yds", 1); got != "[2] Bob - Fairway, 142 yds (best)" {
+		t.Errorf("formatBallChoice for the suggested ball = %q", got)
+	}
+	if got := formatBallChoice(0, "Alice - Rough, 150 yds", 1); got != "[1] Alice - Rough, 150 yds" {
+		t.Errorf("formatBallChoice = %q", got)
+	}
+}
+
+func TestCycleIndex(t *testing.T) {
+	if got := cycleIndex(0, -1, 14); got != 13 {
+		t.Errorf("cycling back from the first club = %d, want 13", got)
+	}
+	if got := cycleIndex(13, 1, 14); got != 0 {
+		t.Errorf("cycling on from the last club = %d, want 0", got)
+	}
+	if got := cycleIndex(4, 1, 14); got != 5 {
+		t.Errorf("cycleIndex(4, 1, 14) = %d, want 5", got)
+	}
+}
@@ -0,0 +1,81 @@
+package ui
+
+import (
+	"fmt"
+	"gogolf"
+	"io"
+)
+
+// Records screen settings
+const (
+	recordsBestRounds  = 5
+	recordsTrendRounds = 10
+	recordsTrendWindow = 5
+)
+
+// recordsDate is how a round's date is shown
+const recordsDate = "2006-01-02"
+
+// FormatBestRounds lists the rounds best first
+func FormatBestRounds(rounds []gogolf.RoundRecord) []string {
+	lines := make([]string, len(rounds))
+	for i, round := range rounds {
+		lines[i] = fmt.Sprintf("%d. %3d (%s)  %s  %s, %s", i+1,
+			round.Strokes(), formatToPar(round.ToPar()), round.PlayedAt.Format(recordsDate), round.Course, round.Format)
+	}
+	return lines
+}
+
+// FormatCourseAverages lists each course's rounds, average and best
+func FormatCourseAverages(averages []gogolf.CourseAverage) []string {
+	lines := make([]string, len(averages))
+	for i, average := range averages {
+		lines[i] = fmt.Sprintf("%s (par %d): %d rounds, average %.1f (%+.1f), best %d (%s)",
+			average.Course, average.Par, average.Rounds, average.Average, average.AverageToPar, average.Best, formatToPar(average.Best-average.Par))
+	}
+	return lines
+}
+
+// FormatHoleBests lays out a course's personal best on each hole
+func FormatHoleBests(bests []gogolf.HoleBest) []string {
+	lines := []string{"Hole  Par  Best  Avg"}
+	for _, best := range bests {
+		lines = append(lines, fmt.Sprintf("%4d  %3d  %4d  %3.1f", best.Number, best.Par, best.Best, best.Average))
+	}
+	return lines
+}
+
+// FormatTrend lists the most recent rounds' scores to par beside the running average
+func FormatTrend(trend []gogolf.TrendPoint) []string {
+	if len(trend) > recordsTrendRounds {
+		trend = trend[len(trend)-recordsTrendRounds:]
+	}
+	lines := make([]string, len(trend))
+	for i, point := range trend {
+		lines[i] = fmt.Sprintf("%s  %3s  avg %+.1f", point.PlayedAt.Format(recordsDate), formatToPar(point.ToPar), point.Average)
+	}
+	return lines
+}
+
+// ShowRecords prints the personal bests, course averages and scoring trend from the history
+func ShowRecords(w io.Writer, history gogolf.RoundHistory) {
+	fmt.Fprintln(w, "\n=== Records ===")
+	if len(history) == 0 {
+		fmt.Fprintln(w, "No rounds played yet")
+		return
+	}
+
+	section := func(title string, lines []string) {
+		fmt.Fprintf(w, "\n%s\n", title)
+		for _, line := range lines {
+			fmt.Fprintln(w, line)
+		}
+	}
+	section("Best Rounds", FormatBestRounds(history.Best(recordsBestRounds)))
+	averages := history.CourseAverages()
+	section("Courses", FormatCourseAverages(averages))
+	for _, average := range averages {
+		section(fmt.Sprintf("Personal Bests: %s", average.Course), FormatHoleBests(history.HoleBests(average.Course)))
+	}
+	section(fmt.Sprintf("Trend (average of last %d)", recordsTrendWindow), FormatTrend(history.Trend(recordsTrendWindow)))
+}
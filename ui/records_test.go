@@ -0,0 +1,72 @@
+package ui
+
+import (
+	"bytes"
+	"gogolf"
+	"strings"
+	"testing"
+	"time"
+)
+
+func recordOf(course string, day int, scores ...int) gogolf.RoundRecord {
+	round := gogolf.RoundRecord{
+		Golfer:    "Tester",
+		Course:    course,
+		PlayedAt:  time.Date(2024, 6, day, 10, 0, 0, 0, time.UTC),
+		Format:    "Stroke Play",
+		HoleCount: len(scores),
+	}
+	for i, strokes := range scores {
+		round.Holes = append(round.Holes, gogolf.HoleRecord{Number: i + 1, Par: 4, Strokes: strokes})
+	}
+	return round
+}
+
+func TestFormatBestRounds(t *testing.T) {
+	lines := FormatBestRounds([]gogolf.RoundRecord{recordOf("Links", 3, 3, 4, 4)})
+
+	if len(lines) != 1 || !strings.HasPrefix(lines[0], "1.") {
+		t.Fatalf("expected one numbered round, got %q", lines)
+	}
+	for _, want := range []string{"11", "-1", "2024-06-03", "Links", "Stroke Play"} {
+		if !strings.Contains(lines[0], want) {
+			t.Errorf("expected %q in %q", want, lines[0])
+		}
+	}
+}
+
+func TestFormatCourseAverages(t *testing.T) {
+	lines := FormatCourseAverages([]gogolf.CourseAverage{{Course: "Links", Rounds: 2, Par: 12, Average: 13.5, AverageToPar: 1.5, Best: 12}})
+	if len(lines) != 1 || !strings.Contains(lines[0], "average 13.5 (+1.5)") || !strings.Contains(lines[0], "best 12 (E)") {
+		t.Errorf("unexpected course line %q", lines)
+	}
+}
+
+func TestFormatTrendShowsTheMostRecentRounds(t *testing.T) {
+	var trend []gogolf.TrendPoint
+	for day := 1; day <= recordsTrendRounds+2; day++ {
+		trend = append(trend, gogolf.TrendPoint{PlayedAt: time.Date(2024, 6, day, 0, 0, 0, 0, time.UTC), ToPar: day})
+	}
+
+	lines := FormatTrend(trend)
+
+	if len(lines) != recordsTrendRounds || !strings.HasPrefix(lines[0], "2024-06-03") {
+		t.Errorf("expected the last %d rounds, got %q", recordsTrendRounds, lines)
+	}
+}
+
+func TestShowRecords(t *testing.T) {
+	var out bytes.Buffer
+	ShowRecords(&out, nil)
+	if !strings.Contains(out.String(), "No rounds played yet") {
+		t.Errorf("expected an empty history to say so, got %q", out.String())
+	}
+
+	out.Reset()
+	ShowRecords(&out, gogolf.RoundHistory{recordOf("Links", 1, 4, 5, 4), recordOf("Park", 2, 3, 4)})
+	for _, want := range []string{"Best Rounds", "Courses", "Personal Bests: Links", "Personal Bests: Park", "Trend"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected %q in the records screen, got %q", want, out.String())
+		}
+	}
+}
@@ -3,6 +3,7 @@ package ui
 import (
 	"fmt"
 	"gogolf"
+	"math"
 	"strings"
 )
 
@@ -98,6 +99,10 @@ func (r *Renderer) RenderLeftPanel(state GameState) {
 	row++
 	r.printInPanel(panel, row, fmt.Sprintf("Distance: %.0f yards", state.HoleDistance), false)
 	row++
+	if state.HasWeather {
+		r.printInPanel(panel, row, formatWeather(state), false)
+		row++
+	}
 	row++ // blank line
 
 	// Current lie
@@ -110,10 +115,22 @@ func (r *Renderer) RenderLeftPanel(state GameState) {
 	if state.IsOnGreen {
 		distanceFeet := gogolf.Yard(state.DistanceToHole).Feet()
 		r.printInPanel(panel, row, fmt.Sprintf("Distance to hole: %.1f feet", distanceFeet), false)
+		if state.Stimp != 0 {
+			row++
+			r.printInPanel(panel, row, formatGreenRead(state), false)
+		}
 	} else {
 		r.printInPanel(panel, row, fmt.Sprintf("Distance to hole: %.1f yards", state.DistanceToHole), false)
+		if math.Abs(state.ElevationChange) >= 1 {
+			row++
+			r.printInPanel(panel, row, formatPlaysLike(state), false)
+		}
 	}
 	row++
+	if state.Stance != "" && state.StanceDifficulty != 0 {
+		r.printInPanel(panel, row, fmt.Sprintf("Stance: %s (difficulty: %+d)", state.Stance, state.StanceDifficulty), false)
+		row++
+	}
 	row++ // blank line
 
 	// Last shot info
@@ -121,7 +138,11 @@ func (r *Renderer) RenderLeftPanel(state GameState) {
 		shot := state.LastShot
 		r.printInPanel(panel, row, "Last Shot:", false)
 		row++
-		r.printInPanel(panel, row, fmt.Sprintf("├─ Club: %s", shot.ClubName), false)
+		club := shot.ClubName
+		if shot.ShotType != "" {
+			club = fmt.Sprintf("%s (%s)", shot.ClubName, shot.ShotType)
+		}
+		r.printInPanel(panel, row, fmt.Sprintf("├─ Club: %s", club), false)
 		row++
 		r.printInPanel(panel, row, fmt.Sprintf("├─ Target: %d | Dice: %s", shot.TargetNumber, formatDiceRolls(shot.DiceRolls)), false)
 		row++
@@ -131,9 +152,25 @@ func (r *Renderer) RenderLeftPanel(state GameState) {
 		row++
 		r.printInPanel(panel, row, fmt.Sprintf("├─ Rotation: %.1f° %s", shot.Rotation, shot.RotationDir), false)
 		row++
+		if shot.Aim != "" {
+			r.printInPanel(panel, row, fmt.Sprintf("├─ Aim: %s (%s)", shot.Aim, formatOffLine(shot.OffLine)), false)
+			row++
+		}
 		r.printInPanel(panel, row, fmt.Sprintf("├─ Power: %.0f%%", shot.Power*100), false)
 		row++
-		r.printInPanel(panel, row, fmt.Sprintf("└─ Distance: %.1f yards", shot.Distance), false)
+		if shot.Obstacle != "" {
+			r.printInPanel(panel, row, fmt.Sprintf("├─ Hit: %s", shot.Obstacle), false)
+			row++
+		}
+		if shot.Penalty != "" {
+			r.printInPanel(panel, row, fmt.Sprintf("├─ Penalty: %s", shot.Penalty), false)
+			row++
+		}
+		if shot.Apex > 0 {
+			r.printInPanel(panel, row, fmt.Sprintf("├─ Flight: %s", formatFlight(*shot)), false)
+			row++
+		}
+		r.printInPanel(panel, row, fmt.Sprintf("└─ Distance: %.1f yards (carry %.1f, roll %.1f)", shot.Distance, shot.Carry, shot.Roll), false)
 		row++
 		row++ // blank line
 
@@ -182,10 +219,34 @@ func (r *Renderer) RenderRightPanel(state GameState) {
 	row++
 	r.printInPanel(panel, row, fmt.Sprintf("Player: %s", state.PlayerName), false)
 	row++
+	if state.Turn != "" {
+		r.printInPanel(panel, row, fmt.Sprintf("Turn: %s (%s)", state.PlayerName, state.Turn), false)
+		row++
+	}
 	r.printInPanel(panel, row, fmt.Sprintf("Money: %s", colorizeMoney(state.Money)), false)
 	row++
 	row++ // blank line
 
+	// Leaderboard
+	if len(state.Leaderboard) > 0 {
+		r.printInPanel(panel, row, "--- Leaderboard ---", false)
+		row++
+		for i, entry := range state.Leaderboard {
+			r.printInPanel(panel, row, formatLeaderboardEntry(i+1, entry), false)
+			row++
+		}
+		row++ // blank line
+	}
+
+	// Match play
+	if state.MatchStatus != "" {
+		r.printInPanel(panel, row, "--- Match ---", false)
+		row++
+		r.printInPanel(panel, row, state.MatchStatus, false)
+		row++
+		row++ // blank line
+	}
+
 	// Skills
 	r.printInPanel(panel, row, "--- Skills ---", false)
 	row++
@@ -244,6 +305,10 @@ func (r *Renderer) RenderRightPanel(state GameState) {
 	}
 	r.printInPanel(panel, row, fmt.Sprintf("Total: %d (%s)", state.TotalStrokes, scoreStr), false)
 	row++
+	if state.ScoringFormat != "" {
+		r.printInPanel(panel, row, fmt.Sprintf("%s: %s", state.ScoringFormat, state.Points), false)
+		row++
+	}
 	r.printInPanel(panel, row, fmt.Sprintf("This Hole: %d strokes", state.StrokesThisHole), false)
 	row++
 	r.printInPanel(panel, row, fmt.Sprintf("Holes: %d/%d", state.HoleNumber, state.TotalHoles), false)
@@ -268,3 +333,97 @@ func (r *Renderer) printInPanel(panel Panel, row int, text string, centered bool
 	r.Terminal.MoveCursor(row, panel.X+2) // +2 for left margin
 	fmt.Print(text)
 }
+
+// formatOffLine describes where a shot finished relative to its aim line
+func formatOffLine(yards float64) string {
+	switch {
+	case yards >= 0.5:
+		return fmt.Sprintf("%.1f yds right", yards)
+	case yards <= -0.5:
+		return fmt.Sprintf("%.1f yds left", -yards)
+	default:
+		return "on line"
+	}
+}
+
+// formatLeaderboardEntry lays out one line of the leaderboard, e.g. "> 1. Alice           -1  (4 thru 1)"
+func formatLeaderboardEntry(position int, entry LeaderboardEntry) string {
+	marker := " "
+	if entry.Current {
+		marker = ">"
+	}
+	if entry.Points != "" {
+		return fmt.Sprintf("%s %d. %-14s %s  (thru %d)", marker, position, entry.Name, entry.Points, entry.Thru)
+	}
+	score := fmt.Sprintf("%+d", entry.ScoreToPar)
+	if entry.ScoreToPar == 0 {
+		score = "E"
+	}
+	line := fmt.Sprintf("%s %d. %-14s %3s  (%d thru %d)", marker, position, entry.Name, score, entry.Strokes, entry.Thru)
+	if entry.PickedUp > 0 {
+		line += fmt.Sprintf(" %d PU", entry.PickedUp)
+	}
+	return line
+}
+
+// formatFlight describes how the ball flew and landed, e.g. "Low, apex 45 ft | Checked Up"
+func formatFlight(shot ShotDisplay) string {
+	trajectory := shot.Trajectory
+	if trajectory == "" {
+		trajectory = "Standard"
+	}
+	flight := fmt.Sprintf("%s, apex %.0f ft", trajectory, shot.Apex)
+	if shot.SpinOutcome != "" {
+		flight += " | " + shot.SpinOutcome
+	}
+	return flight
+}
+
+// windArrows point the way the wind blows, read with the hole straight up the screen
+var windArrows = [...]string{"↑", "↗", "→", "↘", "↓", "↙", "←", "↖"}
+
+// windArrow picks the arrow nearest a wind bearing relative to the line to the hole
+func windArrow(relative float64) string {
+	index := int(math.Round(relative/45)) % len(windArrows)
+	if index < 0 {
+		index += len(windArrows)
+	}
+	return windArrows[index]
+}
+
+// formatPlaysLike describes the effect of elevation, e.g. "Plays like: 162 yards (30 ft uphill)"
+func formatPlaysLike(state GameState) string {
+	direction := "uphill"
+	if state.ElevationChange < 0 {
+		direction = "downhill"
+	}
+	return fmt.Sprintf("Plays like: %.0f yards (%.0f ft %s)", state.PlaysLike, math.Abs(state.ElevationChange), direction)
+}
+
+// formatGreenRead describes the putt, e.g. "Read: 1.5 ft right | 1.2% uphill | Stimp 10"
+func formatGreenRead(state GameState) string {
+	breaks := "straight"
+	switch {
+	case state.BreakFeet >= 0.05:
+		breaks = fmt.Sprintf("%.1f ft right", state.BreakFeet)
+	case state.BreakFeet <= -0.05:
+		breaks = fmt.Sprintf("%.1f ft left", -state.BreakFeet)
+	}
+	grade := "flat"
+	switch {
+	case state.Grade >= 0.05:
+		grade = fmt.Sprintf("%.1f%% uphill", state.Grade)
+	case state.Grade <= -0.05:
+		grade = fmt.Sprintf("%.1f%% downhill", -state.Grade)
+	}
+	return fmt.Sprintf("Read: %s | %s | Stimp %.0f", breaks, grade, state.Stimp)
+}
+
+// formatWeather describes the conditions, e.g. "Wind: ↗ 12 mph (gusts 18) | 64°F | Dry"
+func formatWeather(state GameState) string {
+	wind := "Wind: calm"
+	if state.WindSpeed > 0 {
+		wind = fmt.Sprintf("Wind: %s %.0f mph (gusts %.0f)", windArrow(state.WindRelative), state.WindSpeed, state.WindGust)
+	}
+	return fmt.Sprintf("%s | %.0f°F | %s", wind, state.Temperature, state.Rain)
+}
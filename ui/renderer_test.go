@@ -206,3 +206,104 @@ func TestShotDisplay_HasTargetNumber(t *testing.T) {
 		t.Errorf("ShotDisplay.TargetNumber = %d, want 12", shot.TargetNumber)
 	}
 }
+
+func TestFormatOffLine(t *testing.T) {
+	tests := []struct {
+		yards    float64
+		expected string
+	}{
+		{0.2, "on line"},
+		{3.25, "3.2 yds right"},
+		{-7, "7.0 yds left"},
+	}
+
+	for _, tt := range tests {
+		if got := formatOffLine(tt.yards); got != tt.expected {
+			t.Errorf("formatOffLine(%v) = %q, want %q", tt.yards, got, tt.expected)
+		}
+	}
+}
+
+func TestWindArrow(t *testing.T) {
+	tests := []struct {
+		relative float64
+		expected string
+	}{
+		{0, "↑"},
+		{44, "↗"},
+		{90, "→"},
+		{180, "↓"},
+		{350, "↑"},
+		{-90, "←"},
+	}
+
+	for _, tt := range tests {
+		if got := windArrow(tt.relative); got != tt.expected {
+			t.Errorf("windArrow(%v) = %s, want %s", tt.relative, got, tt.expected)
+		}
+	}
+}
+
+func TestFormatWeather(t *testing.T) {
+	state := GameState{WindSpeed: 12, WindGust: 18, WindRelative: 180, Temperature: 64, Rain: "Dry"}
+
+	if got := formatWeather(state); got != "Wind: ↓ 12 mph (gusts 18) | 64°F | Dry" {
+		t.Errorf("formatWeather = %q", got)
+	}
+	if got := formatWeather(GameState{Temperature: 70, Rain: "Light Rain"}); got != "Wind: calm | 70°F | Light Rain" {
+		t.Errorf("formatWeather calm = %q", got)
+	}
+	if got := formatWeather(GameState{HasWeather: true, Temperature: 0, Rain: "Dry"}); got != "Wind: calm | 0°F | Dry" {
+		t.Errorf("formatWeather freezing = %q", got)
+	}
+}
+
+func TestFormatGreenRead(t *testing.T) {
+	tests := []struct {
+		state    GameState
+		expected string
+	}{
+		{GameState{BreakFeet: 1.5, Grade: 1.2, Stimp: 10}, "Read: 1.5 ft right | 1.2% uphill | Stimp 10"},
+		{GameState{BreakFeet: -0.8, Grade: -2, Stimp: 12}, "Read: 0.8 ft left | 2.0% downhill | Stimp 12"},
+		{GameState{Stimp: 9}, "Read: straight | flat | Stimp 9"},
+	}
+
+	for _, tt := range tests {
+		if got := formatGreenRead(tt.state); got != tt.expected {
+			t.Errorf("formatGreenRead(%+v) = %q, want %q", tt.state, got, tt.expected)
+		}
+	}
+}
+
+func TestFormatPlaysLike(t *testing.T) {
+	if got := formatPlaysLike(GameState{PlaysLike: 162, ElevationChange: 30}); got != "Plays like: 162 yards (30 ft uphill)" {
+		t.Errorf("formatPlaysLike uphill = %q", got)
+	}
+	if got := formatPlaysLike(GameState{PlaysLike: 140, ElevationChange: -30}); got != "Plays like: 140 yards (30 ft downhill)" {
+		t.Errorf("formatPlaysLike downhill = %q", got)
+	}
+}
+
+func TestFormatFlight(t *testing.T) {
+	if got := formatFlight(ShotDisplay{Trajectory: "Low", Apex: 45.2, SpinOutcome: "Checked Up"}); got != "Low, apex 45 ft | Checked Up" {
+		t.Errorf("formatFlight = %q", got)
+	}
+	if got := formatFlight(ShotDisplay{Apex: 80}); got != "Standard, apex 80 ft" {
+		t.Errorf("formatFlight standard = %q", got)
+	}
+}
+
+func TestFormatLeaderboardEntry(t *testing.T) {
+	if got := formatLeaderboardEntry(1, LeaderboardEntry{Name: "Alice", Strokes: 4, ScoreToPar: -1, Thru: 1, Current: true}); got != "> 1. Alice           -1  (4 thru 1)" {
+		t.Errorf("formatLeaderboardEntry = %q", got)
+	}
+	if got := formatLeaderboardEntry(2, LeaderboardEntry{Name: "Bob", Strokes: 10, Thru: 3}); got != "  2. Bob              E  (10 thru 3)" {
+		t.Errorf("formatLeaderboardEntry level par = %q", got)
+	}
+	if got := formatLeaderboardEntry(2, LeaderboardEntry{Name: "Bob", Strokes: 9, ScoreToPar: -1, Thru: 3, PickedUp: 1}); got != "  2. Bob             -1  (9 thru 3) 1 PU" {
+		t.Errorf("formatLeaderboardEntry picked up = %q", got)
+	}
+	if got := formatLeaderboardEntry(1, LeaderboardEntry{Name: "Cara", Points: "7 pts", Thru: 3}); got != "  1. Cara           7 pts  (thru 3)" {
+		t.Errorf("formatLeaderboardEntry with points = %q", got)
+	}
+}
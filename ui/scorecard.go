@@ -0,0 +1,139 @@
+package ui
+
+import (
+	"fmt"
+	"gogolf"
+	"io"
+)
+
+// frontNine is how many holes make up the Out half of a scorecard
+const frontNine = 9
+
+// scoreCardHeader labels the columns of a scorecard row
+const scoreCardHeader = "Hole  Par  SI  Score  +/-  Putts  FIR  GIR"
+
+// scoreCardTotals sums a run of holes for the Out, In and Total rows
+type scoreCardTotals struct {
+	par, strokes, putts  int
+	fairways, fairwayHit int
+	greens               int
+}
+
+func (t *scoreCardTotals) add(hs gogolf.HoleStats) {
+	t.par += hs.Par
+	t.strokes += hs.Strokes
+	t.putts += hs.Putts
+	if hs.FairwayChance {
+		t.fairways++
+		if hs.FairwayHit {
+			t.fairwayHit++
+		}
+	}
+	if hs.GreenInRegulation {
+		t.greens++
+	}
+}
+
+// formatScoreCardHole is one hole's row: a hole not yet played shows only its par and stroke index,
+// and a picked-up hole shows PU in place of its score to par
+func formatScoreCardHole(hs gogolf.HoleStats, strokeIndex int) string {
+	if hs.Strokes == 0 && !hs.PickedUp {
+		return fmt.Sprintf("%4d  %3d  %2d  %5s  %3s  %5s  %3s  %3s", hs.Number, hs.Par, strokeIndex, "-", "", "", "", "")
+	}
+	fairway := "-"
+	if hs.FairwayChance {
+		fairway = mark(hs.FairwayHit)
+	}
+	toPar := formatToPar(hs.Strokes - hs.Par)
+	if hs.PickedUp {
+		toPar = "PU"
+	}
+	return fmt.Sprintf("%4d  %3d  %2d  %5d  %3s  %5d  %3s  %3s",
+		hs.Number, hs.Par, strokeIndex, hs.Strokes, toPar, hs.Putts, fairway, mark(hs.GreenInRegulation))
+}
+
+func formatTotals(label string, t scoreCardTotals) string {
+	return fmt.Sprintf("%4s  %3d  %2s  %5d  %3s  %5d  %3s  %3d",
+		label, t.par, "", t.strokes, formatToPar(t.strokes-t.par), t.putts, fmt.Sprintf("%d/%d", t.fairwayHit, t.fairways), t.greens)
+}
+
+// FormatScoreCard lays out the full card: a row per hole, then Out and In rows for a round longer
+// than nine holes and a Total row. Totals count only the holes played.
+func FormatScoreCard(card gogolf.ScoreCard) []string {
+	lines := []string{scoreCardHeader}
+	var out, in, total scoreCardTotals
+	for i, hole := range card.Course.Holes {
+		hs := card.HoleStats(hole)
+		lines = append(lines, formatScoreCardHole(hs, card.Course.StrokeIndex(hole)))
+		if hs.Strokes == 0 {
+			continue
+		}
+		if i < frontNine {
+			out.add(hs)
+		} else {
+			in.add(hs)
+		}
+		total.add(hs)
+	}
+	if len(card.Course.Holes) > frontNine {
+		lines = append(lines, formatTotals("Out", out), formatTotals("In", in))
+	}
+	lines = append(lines, formatTotals("Tot", total))
+	if card.CourseHandicap != 0 {
+		lines = append(lines, fmt.Sprintf("Net %d (%s) off a course handicap of %d",
+			card.NetTotalStrokes(), formatToPar(card.NetTotalStrokes()-total.par), card.CourseHandicap))
+	}
+	return lines
+}
+
+// FormatRoundStats summarises the round's stats as made out of chances
+func FormatRoundStats(stats gogolf.RoundStats) []string {
+	return []string{
+		fmt.Sprintf("Fairways: %s", formatRate(stats.FairwaysHit, stats.Fairways)),
+		fmt.Sprintf("Greens in regulation: %s", formatRate(stats.GreensInRegulation, stats.Holes)),
+		fmt.Sprintf("Putts: %d (%.1f per hole)", stats.Putts, perHole(stats.Putts, stats.Holes)),
+		fmt.Sprintf("Scrambling: %s", formatRate(stats.Scrambles, stats.ScrambleChances)),
+		fmt.Sprintf("Up and downs: %s", formatRate(stats.UpAndDowns, stats.UpAndDownChances)),
+		fmt.Sprintf("Sand saves: %s", formatRate(stats.SandSaves, stats.SandSaveChances)),
+	}
+}
+
+// ShowScoreCard prints the card and the round's stats
+func ShowScoreCard(w io.Writer, name string, card gogolf.ScoreCard) {
+	fmt.Fprintf(w, "\n=== Scorecard: %s ===\n", name)
+	for _, line := range FormatScoreCard(card) {
+		fmt.Fprintln(w, line)
+	}
+	fmt.Fprintln(w)
+	for _, line := range FormatRoundStats(card.Stats()) {
+		fmt.Fprintln(w, line)
+	}
+}
+
+func formatToPar(score int) string {
+	if score == 0 {
+		return "E"
+	}
+	return fmt.Sprintf("%+d", score)
+}
+
+func formatRate(made, chances int) string {
+	if chances == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%d/%d (%d%%)", made, chances, made*100/chances)
+}
+
+func perHole(total, holes int) float64 {
+	if holes == 0 {
+		return 0
+	}
+	return float64(total) / float64(holes)
+}
+
+func mark(hit bool) string {
+	if hit {
+		return "Y"
+	}
+	return "N"
+}
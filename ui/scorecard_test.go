@@ -0,0 +1,86 @@
+package ui
+
+import (
+	"bytes"
+	"gogolf"
+	"strings"
+	"testing"
+)
+
+func scoreCardOf(holes int, strokes int) gogolf.ScoreCard {
+	course := gogolf.Course{}
+	for i := 1; i <= holes; i++ {
+		course.Holes = append(course.Holes, gogolf.Hole{Number: i, Par: 4})
+	}
+	card := gogolf.NewScoreCard(course)
+	for _, hole := range course.Holes {
+		for range strokes {
+			card.RecordStroke(hole)
+		}
+		card.AddShot(hole, gogolf.ShotRecord{StartLie: gogolf.Tee, EndLie: gogolf.Fairway, FromHole: 400, ToHole: 150})
+		card.AddShot(hole, gogolf.ShotRecord{StartLie: gogolf.Fairway, EndLie: gogolf.Green, FromHole: 150, ToHole: 5})
+		for range strokes - 2 {
+			card.AddShot(hole, gogolf.ShotRecord{StartLie: gogolf.Green, EndLie: gogolf.Green, Putt: true})
+		}
+	}
+	return card
+}
+
+func TestFormatScoreCardHole(t *testing.T) {
+	played := formatScoreCardHole(gogolf.HoleStats{Number: 7, Par: 4, Strokes: 5, Putts: 2, FairwayChance: true, FairwayHit: true}, 3)
+	if !strings.Contains(played, "+1") || !strings.HasPrefix(strings.TrimSpace(played), "7") {
+		t.Errorf("hole row should show the hole and its score to par, got %q", played)
+	}
+
+	unplayed := formatScoreCardHole(gogolf.HoleStats{Number: 8, Par: 3}, 17)
+	if !strings.Contains(unplayed, "17") || !strings.Contains(unplayed, "-") {
+		t.Errorf("an unplayed hole should show its stroke index and no score, got %q", unplayed)
+	}
+
+	pickedUp := formatScoreCardHole(gogolf.HoleStats{Number: 9, Par: 4, Strokes: 2, PickedUp: true}, 5)
+	if !strings.Contains(pickedUp, "PU") || strings.Contains(pickedUp, "-2") {
+		t.Errorf("a picked-up hole should be marked PU rather than scored to par, got %q", pickedUp)
+	}
+}
+
+func TestFormatScoreCardOutInTotal(t *testing.T) {
+	lines := FormatScoreCard(scoreCardOf(18, 4))
+
+	if len(lines) != 1+18+3 {
+		t.Fatalf("expected a header, 18 holes and out/in/total rows, got %d lines", len(lines))
+	}
+	out, in, total := lines[19], lines[20], lines[21]
+	if !strings.HasPrefix(strings.TrimSpace(out), "Out") || !strings.Contains(out, "36") {
+		t.Errorf("Out row should total the front nine, got %q", out)
+	}
+	if !strings.HasPrefix(strings.TrimSpace(in), "In") || !strings.Contains(in, "9/9") {
+		t.Errorf("In row should count the back nine's fairways, got %q", in)
+	}
+	if !strings.Contains(total, "72") || !strings.Contains(total, "36") || !strings.Contains(total, " E") {
+		t.Errorf("Total row should show 72 strokes and 36 putts at even par, got %q", total)
+	}
+}
+
+func TestFormatScoreCardShortRoundHasOnlyTotal(t *testing.T) {
+	lines := FormatScoreCard(scoreCardOf(3, 5))
+
+	if len(lines) != 1+3+1 {
+		t.Fatalf("expected a header, 3 holes and a total row, got %d lines", len(lines))
+	}
+	if total := lines[4]; !strings.Contains(total, "15") || !strings.Contains(total, "+3") {
+		t.Errorf("Total row = %q, want 15 strokes at +3", total)
+	}
+}
+
+func TestShowScoreCardIncludesStats(t *testing.T) {
+	var output bytes.Buffer
+
+	ShowScoreCard(&output, "Player", scoreCardOf(3, 4))
+
+	text := output.String()
+	for _, want := range []string{"Scorecard: Player", "Fairways: 3/3 (100%)", "Greens in regulation: 3/3 (100%)", "Putts: 6 (2.0 per hole)", "Sand saves: -"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("scorecard output missing %q:\n%s", want, text)
+		}
+	}
+}
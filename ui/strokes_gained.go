@@ -0,0 +1,29 @@
+package ui
+
+import (
+	"fmt"
+	"gogolf"
+	"io"
+)
+
+// FormatStrokesGained lists the strokes gained in each category and in total, then the skill
+// whose shots lost the most as the one to train
+func FormatStrokesGained(sg gogolf.StrokesGained) []string {
+	var lines []string
+	for _, category := range gogolf.ShotCategories() {
+		lines = append(lines, fmt.Sprintf("%-17s %+5.1f", category.String()+":", sg.ByCategory[category]))
+	}
+	lines = append(lines, fmt.Sprintf("%-17s %+5.1f", "Total:", sg.Total()))
+	if skill, gained := sg.WeakestSkill(); skill != "" && gained < 0 {
+		lines = append(lines, fmt.Sprintf("Train: %s (%+.1f)", skill, gained))
+	}
+	return lines
+}
+
+// ShowStrokesGained prints the round's strokes gained breakdown
+func ShowStrokesGained(w io.Writer, sg gogolf.StrokesGained) {
+	fmt.Fprintln(w, "\n=== Strokes Gained ===")
+	for _, line := range FormatStrokesGained(sg) {
+		fmt.Fprintln(w, line)
+	}
+}
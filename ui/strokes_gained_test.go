@@ -0,0 +1,47 @@
+package ui
+
+import (
+	"bytes"
+	"gogolf"
+	"strings"
+	"testing"
+)
+
+func TestFormatStrokesGained(t *testing.T) {
+	sg := gogolf.StrokesGained{
+		ByCategory: map[gogolf.ShotCategory]float64{gogolf.OffTheTee: 1.25, gogolf.Approach: -2.5, gogolf.AroundTheGreen: 0, gogolf.Putting: -0.5},
+		BySkill:    map[string]float64{"Driver": 1.25, "Mid Irons": -2.5, "Putter": -0.5},
+	}
+
+	lines := FormatStrokesGained(sg)
+
+	if len(lines) != 6 {
+		t.Fatalf("expected four categories, a total and a skill to train, got %q", lines)
+	}
+	if !strings.HasPrefix(lines[0], "Off the Tee:") || !strings.Contains(lines[0], "+1.2") {
+		t.Errorf("expected off the tee first, got %q", lines[0])
+	}
+	if !strings.Contains(lines[4], "Total:") || !strings.Contains(lines[4], "-1.8") {
+		t.Errorf("expected the total, got %q", lines[4])
+	}
+	if lines[5] != "Train: Mid Irons (-2.5)" {
+		t.Errorf("expected to train mid irons, got %q", lines[5])
+	}
+}
+
+func TestFormatStrokesGainedNothingToTrain(t *testing.T) {
+	sg := gogolf.StrokesGained{BySkill: map[string]float64{"Putter": 0.4}}
+	for _, line := range FormatStrokesGained(sg) {
+		if strings.HasPrefix(line, "Train:") {
+			t.Errorf("no skill lost strokes, got %q", line)
+		}
+	}
+}
+
+func TestShowStrokesGained(t *testing.T) {
+	var out bytes.Buffer
+	ShowStrokesGained(&out, gogolf.StrokesGained{})
+	if !strings.Contains(out.String(), "Strokes Gained") || !strings.Contains(out.String(), "Putting:") {
+		t.Errorf("expected a heading and the categories, got %q", out.String())
+	}
+}
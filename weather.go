@@ -0,0 +1,178 @@
+package gogolf
+
+import (
+	"fmt"
+	"math"
+)
+
+type Rain int
+
+const (
+	NoRain Rain = iota
+	LightRain
+	HeavyRain
+)
+
+func (r Rain) String() string {
+	return [...]string{
+		"Dry",
+		"Light Rain",
+		"Heavy Rain",
+	}[r]
+}
+
+// standardTemperature is the temperature, in Fahrenheit, at which clubs fly their listed distance
+const standardTemperature = 70.0
+
+// Weather is the conditions for a round. Wind direction is the compass bearing the wind blows
+// toward on the course grid: 0 blows down the +Y axis and 90 toward +X.
+type Weather struct {
+	WindSpeed     float64 // mph
+	WindDirection float64 // degrees
+	GustSpeed     float64 // mph a gust can add on top of WindSpeed
+	Temperature   float64 // Fahrenheit
+	Rain          Rain
+}
+
+// GenerateWeather rolls the conditions for a round
+func GenerateWeather(random RandomSource) Weather {
+	windSpeed := float64(random.IntN(21))
+	weather := Weather{
+		WindSpeed:     windSpeed,
+		WindDirection: random.Float64() * 360,
+		GustSpeed:     math.Round(windSpeed * (0.2 + random.Float64()*0.4)),
+		Temperature:   float64(45 + random.IntN(51)),
+	}
+	switch roll := random.IntN(10); {
+	case roll == 9:
+		weather.Rain = HeavyRain
+	case roll >= 7:
+		weather.Rain = LightRain
+	}
+	return weather
+}
+
+// CalmWeather is a still, dry day at the standard temperature
+func CalmWeather() Weather {
+	return Weather{Temperature: standardTemperature}
+}
+
+// ForShot returns the conditions a single shot meets: a gust may strengthen the wind
+// and swing it up to 15 degrees either way
+func (w Weather) ForShot(random RandomSource) Conditions {
+	return Conditions{
+		WindSpeed:     w.WindSpeed + random.Float64()*w.GustSpeed,
+		WindDirection: w.WindDirection + (random.Float64()*2-1)*15,
+		Temperature:   w.Temperature,
+		Rain:          w.Rain,
+	}
+}
+
+// RelativeWindDirection returns the wind bearing relative to line: 0 blows straight along it
+// (helping), 180 straight back (hurting) and 90 from left to right
+func (w Weather) RelativeWindDirection(line Vector) float64 {
+	return relativeBearing(w.WindDirection, line)
+}
+
+func (w Weather) String() string {
+	return fmt.Sprintf("Wind %.0f mph (gusts %.0f), %.0f°F, %s", w.WindSpeed, w.WindSpeed+w.GustSpeed, w.Temperature, w.Rain)
+}
+
+// Conditions are the weather as one shot meets it
+type Conditions struct {
+	WindSpeed     float64 // mph
+	WindDirection float64 // degrees, see Weather
+	Temperature   float64 // Fahrenheit
+	Rain          Rain
+}
+
+// Calm returns still, dry conditions at the standard temperature, in which shots fly their listed distance
+func Calm() Conditions {
+	return Conditions{Temperature: standardTemperature}
+}
+
+// Wind returns the wind as a vector in mph along the course grid
+func (c Conditions) Wind() Vector {
+	direction := Vector{X: 0, Y: 1}.Rotate(c.WindDirection)
+	return Vector{X: direction.X * c.WindSpeed, Y: direction.Y * c.WindSpeed}
+}
+
+// windComponents splits the wind into the part blowing along direction (positive helps)
+// and the part blowing across it (positive pushes the ball right)
+func (c Conditions) windComponents(direction Vector) (along, across float64) {
+	if direction.Magnitude() == 0 || c.WindSpeed == 0 {
+		return 0, 0
+	}
+	forward := direction.Normalize()
+	wind := c.Wind()
+	return wind.Dot(forward), wind.Dot(forward.Rotate(90))
+}
+
+// CarryMultiplier scales how far a ball flies along direction. A headwind costs about 1% per mph
+// and a tailwind gains half that; warm air carries further and rain knocks the ball down.
+func (c Conditions) CarryMultiplier(direction Vector) float64 {
+	along, _ := c.windComponents(direction)
+	multiplier := 1.0
+	if along >= 0 {
+		multiplier += along * 0.005
+	} else {
+		multiplier += along * 0.01
+	}
+
+	multiplier += (c.Temperature - standardTemperature) / 10 * 0.01
+
+	switch c.Rain {
+	case LightRain:
+		multiplier -= 0.03
+	case HeavyRain:
+		multiplier -= 0.07
+	}
+	return math.Max(multiplier, 0.5)
+}
+
+// Drift is how far, in yards, a crosswind pushes a ball that carries carry yards along direction.
+// Positive drift is to the right.
+func (c Conditions) Drift(direction Vector, carry Yard) Yard {
+	_, across := c.windComponents(direction)
+	return Yard(across * float64(carry) * 0.006)
+}
+
+// RollMultiplier scales how far the ball runs out on wet ground
+func (c Conditions) RollMultiplier() float64 {
+	switch c.Rain {
+	case LightRain:
+		return 0.8
+	case HeavyRain:
+		return 0.6
+	default:
+		return 1
+	}
+}
+
+// DifficultyModifier is the penalty to a target number for playing in these conditions.
+// Putts are not troubled by the wind.
+func (c Conditions) DifficultyModifier(club Club) int {
+	modifier := 0
+	if club.Name != "Putter" {
+		modifier -= int(c.WindSpeed / 10)
+	}
+	switch c.Rain {
+	case LightRain:
+		modifier--
+	case HeavyRain:
+		modifier -= 2
+	}
+	if c.Temperature < 50 {
+		modifier--
+	}
+	return modifier
+}
+
+// relativeBearing returns bearing measured from line in degrees within [0, 360)
+func relativeBearing(bearing float64, line Vector) float64 {
+	if line.Magnitude() == 0 {
+		return math.Mod(math.Mod(bearing, 360)+360, 360)
+	}
+	lineBearing := math.Atan2(line.X, line.Y) * 180 / math.Pi
+	return math.Mod(math.Mod(bearing-lineBearing, 360)+360, 360)
+}
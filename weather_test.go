@@ -0,0 +1,112 @@
+package gogolf
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestGenerateWeather_IsSeedable(t *testing.T) {
+	first := GenerateWeather(rand.New(rand.NewPCG(9, 9)))
+	second := GenerateWeather(rand.New(rand.NewPCG(9, 9)))
+
+	if first != second {
+		t.Errorf("same seed produced different weather: %+v vs %+v", first, second)
+	}
+	if first.WindSpeed < 0 || first.WindSpeed > 20 || first.Temperature < 45 || first.Temperature > 95 {
+		t.Errorf("weather out of range: %+v", first)
+	}
+}
+
+func TestWeather_ForShotGustsWithinLimits(t *testing.T) {
+	weather := Weather{WindSpeed: 10, WindDirection: 90, GustSpeed: 5, Temperature: 70}
+	random := rand.New(rand.NewPCG(1, 2))
+
+	for range 100 {
+		conditions := weather.ForShot(random)
+		if conditions.WindSpeed < 10 || conditions.WindSpeed > 15 {
+			t.Fatalf("gusted wind %.1f outside 10-15 mph", conditions.WindSpeed)
+		}
+		if math.Abs(conditions.WindDirection-90) > 15 {
+			t.Fatalf("gusted direction %.1f swung more than 15 degrees", conditions.WindDirection)
+		}
+	}
+}
+
+func TestConditions_CarryMultiplier(t *testing.T) {
+	downHole := Vector{X: 0, Y: 1}
+
+	if got := Calm().CarryMultiplier(downHole); got != 1 {
+		t.Errorf("calm carry multiplier = %v, want 1", got)
+	}
+
+	tailwind := Conditions{WindSpeed: 10, WindDirection: 0, Temperature: 70}
+	headwind := Conditions{WindSpeed: 10, WindDirection: 180, Temperature: 70}
+	if got := tailwind.CarryMultiplier(downHole); math.Abs(got-1.05) > 1e-9 {
+		t.Errorf("10 mph tailwind multiplier = %v, want 1.05", got)
+	}
+	if got := headwind.CarryMultiplier(downHole); math.Abs(got-0.9) > 1e-9 {
+		t.Errorf("10 mph headwind multiplier = %v, want 0.9", got)
+	}
+
+	coldAndWet := Conditions{Temperature: 50, Rain: HeavyRain}
+	if got := coldAndWet.CarryMultiplier(downHole); math.Abs(got-0.91) > 1e-9 {
+		t.Errorf("cold heavy rain multiplier = %v, want 0.91", got)
+	}
+}
+
+func TestConditions_DriftFollowsCrosswind(t *testing.T) {
+	downHole := Vector{X: 0, Y: 1}
+	leftToRight := Conditions{WindSpeed: 10, WindDirection: 90, Temperature: 70}
+
+	if drift := leftToRight.Drift(downHole, 200); drift <= 0 {
+		t.Errorf("left-to-right wind drift = %.1f, want positive (right)", drift)
+	}
+	if drift := (Conditions{WindSpeed: 10, WindDirection: 0}).Drift(downHole, 200); math.Abs(float64(drift)) > 1e-9 {
+		t.Errorf("tailwind should not drift the ball, got %.2f", drift)
+	}
+}
+
+func TestConditions_DifficultyModifier(t *testing.T) {
+	driver := Club{Name: "Driver"}
+	putter := Club{Name: "Putter"}
+	stormy := Conditions{WindSpeed: 22, Temperature: 45, Rain: LightRain}
+
+	if got := Calm().DifficultyModifier(driver); got != 0 {
+		t.Errorf("calm difficulty = %d, want 0", got)
+	}
+	if got := stormy.DifficultyModifier(driver); got != -4 {
+		t.Errorf("stormy difficulty = %d, want -4", got)
+	}
+	if got := stormy.DifficultyModifier(putter); got != -2 {
+		t.Errorf("stormy putting difficulty = %d, want -2 (wind ignored)", got)
+	}
+}
+
+func TestGolfBall_ReceiveHitInWind(t *testing.T) {
+	club := Club{Name: "7 Iron", Distance: 180}
+	downHole := Vector{X: 0, Y: 1}
+
+	calm := GolfBall{}
+	calm.ReceiveHit(club, 1, downHole, Calm())
+	windy := GolfBall{}
+	windy.ReceiveHit(club, 1, downHole, Conditions{WindSpeed: 15, WindDirection: 135, Temperature: 70})
+
+	if windy.Location.Y >= calm.Location.Y {
+		t.Errorf("into the wind the ball should fly shorter: windy %+v calm %+v", windy.Location, calm.Location)
+	}
+	if windy.Location.X <= calm.Location.X {
+		t.Errorf("a wind blowing to the right should drift the ball right: windy %+v calm %+v", windy.Location, calm.Location)
+	}
+}
+
+func TestWeather_RelativeWindDirection(t *testing.T) {
+	weather := Weather{WindDirection: 90}
+
+	if got := weather.RelativeWindDirection(Vector{X: 0, Y: 1}); math.Abs(got-90) > 1e-9 {
+		t.Errorf("relative direction looking down +Y = %v, want 90", got)
+	}
+	if got := weather.RelativeWindDirection(Vector{X: 1, Y: 0}); math.Abs(got) > 1e-9 {
+		t.Errorf("relative direction looking down +X = %v, want 0 (helping)", got)
+	}
+}